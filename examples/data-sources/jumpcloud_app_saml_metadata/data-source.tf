@@ -0,0 +1,8 @@
+data "jumpcloud_app_saml_metadata" "aws" {
+  display_label = "AWS"
+}
+
+resource "aws_iam_saml_provider" "jumpcloud" {
+  name                   = "jumpcloud"
+  saml_metadata_document = data.jumpcloud_app_saml_metadata.aws.metadata_xml
+}
@@ -0,0 +1,4 @@
+data "jumpcloud_policy_templates" "mac_screen_lock" {
+  os_family = "darwin"
+  name      = "screen lock"
+}
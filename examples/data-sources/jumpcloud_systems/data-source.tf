@@ -0,0 +1,12 @@
+# Macs that have not checked in for 30 days
+data "jumpcloud_systems" "stale_macs" {
+  os_family           = "darwin"
+  last_contact_before = timeadd(plantimestamp(), "-720h")
+}
+
+resource "jumpcloud_command" "stale_mac_cleanup" {
+  name         = "Stale Mac cleanup"
+  command_type = "mac"
+  command      = file("${path.module}/scripts/cleanup.sh")
+  system_ids   = data.jumpcloud_systems.stale_macs.systems[*].id
+}
@@ -0,0 +1,10 @@
+# Fails the plan if the address does not belong to exactly one user
+data "jumpcloud_user" "oncall" {
+  email = "oncall@example.com"
+}
+
+resource "jumpcloud_user_system_binding" "oncall_bastion" {
+  user_id      = data.jumpcloud_user.oncall.id
+  system_id    = jumpcloud_system.bastion.id
+  sudo_enabled = true
+}
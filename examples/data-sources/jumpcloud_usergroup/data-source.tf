@@ -0,0 +1,9 @@
+# Fails the plan unless exactly one group has this name
+data "jumpcloud_usergroup" "engineering" {
+  name = "Engineering"
+}
+
+resource "jumpcloud_user_system_binding" "engineering_build" {
+  user_group_id   = data.jumpcloud_usergroup.engineering.id
+  system_group_id = jumpcloud_system_group.build.id
+}
@@ -0,0 +1,16 @@
+# Active engineers hired this year
+data "jumpcloud_users" "new_engineers" {
+  department    = "Engineering"
+  state         = "ACTIVATED"
+  email_domain  = "example.com"
+  created_after = "2024-01-01T00:00:00Z"
+
+  attributes = {
+    location = "Remote"
+  }
+}
+
+resource "jumpcloud_usergroup" "new_engineers" {
+  name    = "New engineers"
+  members = data.jumpcloud_users.new_engineers.users[*].email
+}
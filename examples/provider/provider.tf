@@ -5,10 +5,9 @@ terraform {
     }
   }
 }
-variable "api_key" {
-  type      = string
-  sensitive = true
-}
+
+# The API key is read from the JC_API_KEY environment variable,
+# or from the "staging" profile of ~/.jumpcloud/credentials
 provider "jumpcloud" {
-  api_key = var.api_key
-}
\ No newline at end of file
+  profile = "staging"
+}
@@ -1,7 +1,19 @@
+# Create a custom SAML application
 resource "jumpcloud_app" "example" {
+  name           = "custom-saml-app"
+  display_label  = "Example"
+  logo_url       = "https://example.com/logo.png"
+  sso_url        = "https://sso.jumpcloud.com/saml2/example"
+  acs_url        = "https://example.com/saml/acs"
+  sp_entity_id   = "https://example.com"
+  idp_entity_id  = "https://example.com/jumpcloud"
+  name_id_format = "urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress"
+  sign_assertion = true
+  sign_response  = false
+
   associated_groups = [
     "6515a2000000d60001bc43cb",
     "640000000000000001728dec",
     "006d20000000000001c42905",
   ]
-}
\ No newline at end of file
+}
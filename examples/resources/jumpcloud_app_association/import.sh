@@ -0,0 +1,2 @@
+# App associations can be imported by specifying the `app_id`, `target_type` and `target_id` separated by slashes.
+terraform import jumpcloud_app_association.example 6515a2000000d60001bc43cb/user_group/64f8c031123131314ad6a7ff
@@ -0,0 +1,5 @@
+resource "jumpcloud_app_association" "example" {
+  app_id      = "6515a2000000d60001bc43cb"
+  target_type = "user_group"
+  target_id   = jumpcloud_usergroup.example.id
+}
@@ -0,0 +1,2 @@
+# SAML attributes can be imported by specifying the application ID.
+terraform import jumpcloud_app_saml_attributes.example 6515a2000000d60001bc43cb
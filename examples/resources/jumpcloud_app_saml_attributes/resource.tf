@@ -0,0 +1,18 @@
+resource "jumpcloud_app_saml_attributes" "example" {
+  app_id = jumpcloud_app.example.id
+
+  # Send the names of the user's groups as memberOf
+  include_group_attribute = true
+  group_attribute_name    = "memberOf"
+
+  attribute {
+    name           = "emailAddress"
+    user_attribute = "email"
+  }
+
+  attribute {
+    name           = "https://aws.amazon.com/SAML/Attributes/SessionDuration"
+    constant_value = "3600"
+    name_format    = "urn:oasis:names:tc:SAML:2.0:attrname-format:uri"
+  }
+}
@@ -0,0 +1,2 @@
+# Commands can be imported by specifying the command ID.
+terraform import jumpcloud_command.example 63f4a0000000000001a2b3c4
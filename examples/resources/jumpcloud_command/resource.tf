@@ -0,0 +1,21 @@
+resource "jumpcloud_command" "update_packages" {
+  name         = "Update packages"
+  command_type = "linux"
+  command      = file("${path.module}/scripts/update-packages.sh")
+  timeout      = 600
+
+  # Run every night at 03:00
+  launch_type = "scheduled"
+  schedule    = "0 3 * * *"
+}
+
+resource "jumpcloud_command" "collect_logs" {
+  name         = "Collect logs"
+  command_type = "windows"
+  shell        = "powershell"
+  command      = "Get-EventLog -LogName System -Newest 100"
+
+  # Run through POST /api/command/trigger/collect-logs
+  launch_type = "trigger"
+  trigger     = "collect-logs"
+}
@@ -0,0 +1,24 @@
+resource "jumpcloud_command" "refresh_policies" {
+  name         = "Refresh policies"
+  command_type = "mac"
+  command      = "sudo profiles renew -type enrollment"
+  launch_type  = "trigger"
+  trigger      = "refresh-policies"
+
+  # Authoritative: the command runs on exactly these system groups
+  system_group_ids = [
+    jumpcloud_system_group.macs.id,
+  ]
+}
+
+# Runs the command on every apply that changes the policy
+resource "jumpcloud_command_trigger" "refresh_policies" {
+  trigger = jumpcloud_command.refresh_policies.trigger
+  payload = jsonencode({
+    reason = "screen lock policy changed"
+  })
+
+  triggers = {
+    policy = sha1(jsonencode(jumpcloud_policy.screen_lock.values))
+  }
+}
@@ -0,0 +1,2 @@
+# Graph associations can be imported by specifying `from_type`, `from_id`, `to_type` and `to_id` separated by slashes.
+terraform import jumpcloud_graph_association.example user_group/64f8c031123131314ad6a7ff/system_group/6515a2000000d60001bc43cb
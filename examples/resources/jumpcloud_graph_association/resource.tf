@@ -0,0 +1,14 @@
+# Grant a user group sudo access to a system group
+resource "jumpcloud_graph_association" "example" {
+  from_type = "user_group"
+  from_id   = jumpcloud_usergroup.example.id
+  to_type   = "system_group"
+  to_id     = jumpcloud_system_group.example.id
+
+  attributes = jsonencode({
+    sudo = {
+      enabled         = true
+      withoutPassword = false
+    }
+  })
+}
@@ -0,0 +1,2 @@
+# Policies can be imported by specifying the policy ID.
+terraform import jumpcloud_policy.example 63f4a0000000000001a2b3c4
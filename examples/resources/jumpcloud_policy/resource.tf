@@ -0,0 +1,16 @@
+resource "jumpcloud_policy" "screen_lock" {
+  name          = "Mac screen lock"
+  template_name = "screen_lock_darwin"
+  notes         = "Required by the workstation security standard"
+
+  values = {
+    timeout = "300"
+  }
+}
+
+# Bind the policy to exactly these system groups
+resource "jumpcloud_policy" "firewall" {
+  name             = "Mac firewall"
+  template_name    = "firewall_darwin"
+  system_group_ids = [jumpcloud_system_group.macs.id]
+}
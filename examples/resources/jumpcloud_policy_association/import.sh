@@ -0,0 +1,2 @@
+# Policy associations can be imported by specifying `policy` or `policy_group`, the source ID, the `target_type` and the `target_id` separated by slashes.
+terraform import jumpcloud_policy_association.example policy/63f4a0000000000001a2b3c4/system_group/64f8c031123131314ad6a7ff
@@ -0,0 +1,12 @@
+# Additive: binds one policy to one system group, leaving other bindings alone
+resource "jumpcloud_policy_association" "screen_lock_macs" {
+  policy_id   = jumpcloud_policy.screen_lock.id
+  target_type = "system_group"
+  target_id   = jumpcloud_system_group.macs.id
+}
+
+resource "jumpcloud_policy_association" "baseline_build_agent" {
+  policy_group_id = jumpcloud_policy_group.baseline.id
+  target_type     = "system"
+  target_id       = "64f8c031123131314ad6a7ff"
+}
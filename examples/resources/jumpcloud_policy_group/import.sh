@@ -0,0 +1,2 @@
+# Policy groups can be imported by specifying the policy group ID.
+terraform import jumpcloud_policy_group.example 63f4a0000000000001a2b3c4
@@ -0,0 +1,12 @@
+resource "jumpcloud_policy_group" "baseline" {
+  name        = "Mac baseline"
+  description = "Policies every Mac must have"
+  policies = [
+    jumpcloud_policy.screen_lock.id,
+  ]
+
+  # Authoritative: the group is bound to exactly these system groups
+  system_group_ids = [
+    jumpcloud_system_group.macs.id,
+  ]
+}
@@ -0,0 +1,2 @@
+# Systems can be imported by specifying the system ID.
+terraform import jumpcloud_system.example 63f4a0000000000001a2b3c4
@@ -0,0 +1,24 @@
+# Systems register through the JumpCloud agent, import them to manage their settings
+import {
+  to = jumpcloud_system.bastion
+  id = "63f4a0000000000001a2b3c4"
+}
+
+resource "jumpcloud_system" "bastion" {
+  display_name                      = "bastion-1"
+  description                       = "Production bastion host"
+  allow_ssh_root_login              = false
+  allow_ssh_password_authentication = false
+  allow_multi_factor_authentication = true
+
+  # Authoritative: only these users can log in
+  user_bindings = [
+    {
+      user_id      = jumpcloud_user.oncall.id
+      sudo_enabled = true
+    },
+    {
+      user_id = jumpcloud_user.auditor.id
+    },
+  ]
+}
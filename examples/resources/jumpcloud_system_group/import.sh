@@ -0,0 +1,2 @@
+# System groups can be imported by specifying the system group `id` in the `terraform import` command.
+terraform import jumpcloud_system_group.example 64f8c031123131314ad6a7
@@ -0,0 +1,8 @@
+resource "jumpcloud_system_group" "example" {
+  name        = "example-servers"
+  description = "example description"
+  members = [
+    "64f8c031123131314ad6a7ff",
+    "build-server-01",
+  ]
+}
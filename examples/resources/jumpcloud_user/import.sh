@@ -0,0 +1,4 @@
+# Users can be imported by specifying the user `id`, `username` or `email` in the `terraform import` command.
+terraform import jumpcloud_user.example 64f8c031123131314ad6a7
+terraform import jumpcloud_user.example jdoe
+terraform import jumpcloud_user.example jdoe@example.com
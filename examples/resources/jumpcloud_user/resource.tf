@@ -0,0 +1,15 @@
+resource "jumpcloud_user" "example" {
+  username      = "jdoe"
+  email         = "jdoe@example.com"
+  first_name    = "Jane"
+  last_name     = "Doe"
+  department    = "Engineering"
+  cost_center   = "R&D"
+  employee_type = "Full Time"
+
+  enable_user_portal_multifactor = true
+
+  attributes = {
+    location = "NYC"
+  }
+}
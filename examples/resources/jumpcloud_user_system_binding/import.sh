@@ -0,0 +1,3 @@
+# Bindings can be imported by specifying source_type/source_id/target_type/target_id,
+# where source_type is user or user_group and target_type is system or system_group.
+terraform import jumpcloud_user_system_binding.example user_group/63f4a0000000000001a2b3c4/system_group/63f4a0000000000001a2b3c5
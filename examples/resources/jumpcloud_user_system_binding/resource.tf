@@ -0,0 +1,12 @@
+# Give the on-call group sudo on every production server
+resource "jumpcloud_user_system_binding" "oncall_prod" {
+  user_group_id   = jumpcloud_usergroup.oncall.id
+  system_group_id = jumpcloud_system_group.prod.id
+  sudo_enabled    = true
+}
+
+# Let a single user log in to a single system without sudo
+resource "jumpcloud_user_system_binding" "auditor_bastion" {
+  user_id   = jumpcloud_user.auditor.id
+  system_id = jumpcloud_system.bastion.id
+}
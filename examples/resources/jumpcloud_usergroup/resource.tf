@@ -5,4 +5,23 @@ resource "jumpcloud_usergroup" "example" {
     "example-member-1",
     "example-member-2",
   ]
-}
\ No newline at end of file
+}
+
+resource "jumpcloud_usergroup" "dynamic_example" {
+  name              = "example-engineering"
+  description       = "Everyone in engineering"
+  membership_method = "DYNAMIC_AUTOMATED"
+
+  member_query {
+    match = "all"
+    filter {
+      field = "department"
+      value = "Engineering"
+    }
+    filter {
+      field    = "employeeType"
+      operator = "ne"
+      value    = "Contractor"
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# Memberships can be imported by specifying the `group_id` and the user email or ID separated by a slash.
+terraform import jumpcloud_usergroup_membership.example 64f8c031123131314ad6a7ff/jdoe@example.com
@@ -0,0 +1,4 @@
+resource "jumpcloud_usergroup_membership" "example" {
+  group_id = "64f8c031123131314ad6a7ff"
+  user     = "jdoe@example.com"
+}
@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+)
+
+// jcAPIError is returned when the JumpCloud API answers with a non-2xx status code.
+type jcAPIError struct {
+	Method     string
+	Path       string
+	StatusCode int
+	Message    string
+}
+
+func (e *jcAPIError) Error() string {
+	return fmt.Sprintf("%s %s returned HTTP %d: %s", e.Method, e.Path, e.StatusCode, e.Message)
+}
+
+// isNotFound reports whether err is a 404 response from the JumpCloud API.
+func isNotFound(err error) bool {
+	var apiErr *jcAPIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusNotFound
+}
+
+// jcRequest sends a JSON request to the JumpCloud API and decodes the response into out.
+// The client's HostURL and Headers are copied rather than modified, so concurrent calls
+// from parallel resources do not interfere with each other.
+func jcRequest(ctx context.Context, client *jumpcloud.Client, method, apiPath string, query url.Values, body, out any) (http.Header, error) {
+	// Prepare request
+	u := *client.HostURL
+	u.Path = apiPath
+	u.RawQuery = query.Encode()
+	var reader io.Reader
+	if body != nil {
+		jsonBody, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reader = bytes.NewReader(jsonBody)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reader)
+	if err != nil {
+		return nil, err
+	}
+	req.Header = client.Headers.Clone()
+
+	// Send request
+	response, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	// Parse response
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return response.Header, err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return response.Header, &jcAPIError{
+			Method:     method,
+			Path:       apiPath,
+			StatusCode: response.StatusCode,
+			Message:    apiErrorMessage(data),
+		}
+	}
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return response.Header, err
+		}
+	}
+	return response.Header, nil
+}
+
+// apiErrorMessage extracts a readable message from a JumpCloud error body.
+func apiErrorMessage(body []byte) string {
+	var payload struct {
+		Message string `json:"message"`
+		Error   string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &payload); err == nil {
+		if payload.Message != "" {
+			return payload.Message
+		}
+		if payload.Error != "" {
+			return payload.Error
+		}
+	}
+	return strings.TrimSpace(string(body))
+}
+
+// isObjectID reports whether s looks like a JumpCloud object ID (24 hex characters).
+func isObjectID(s string) bool {
+	if len(s) != 24 {
+		return false
+	}
+	for _, c := range s {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", c) {
+			return false
+		}
+	}
+	return true
+}
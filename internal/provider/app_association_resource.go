@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &jcAppAssociationResource{}
+	_ resource.ResourceWithConfigure   = &jcAppAssociationResource{}
+	_ resource.ResourceWithImportState = &jcAppAssociationResource{}
+)
+
+// appAssociationTargetTypes are the object types an application can be associated with.
+var appAssociationTargetTypes = []string{"user_group", "user", "system_group"}
+
+// NewAppAssociationResource is a helper function to simplify the provider implementation.
+func NewAppAssociationResource() resource.Resource {
+	return &jcAppAssociationResource{}
+}
+
+// jcAppAssociationResource is the resource implementation.
+// Unlike the associated_groups attribute of jcAppResource it manages a single application edge
+// and never touches the other associations of the application.
+type jcAppAssociationResource struct {
+	client *jumpcloud.Client
+}
+
+// AppAssociationResourceModel is the local model for this resource type.
+type AppAssociationResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	AppID      types.String `tfsdk:"app_id"`
+	TargetType types.String `tfsdk:"target_type"`
+	TargetID   types.String `tfsdk:"target_id"`
+	OrgID      types.String `tfsdk:"org_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *jcAppAssociationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_association"
+}
+
+// Schema defines the schema for the resource.
+func (r *jcAppAssociationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The association ID in the form app_id/target_type/target_id",
+				MarkdownDescription: "The association ID in the form `app_id/target_type/target_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "Application ID",
+				MarkdownDescription: "Application ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_type": schema.StringAttribute{
+				Required:            true,
+				Description:         "Can be user_group, user or system_group",
+				MarkdownDescription: "Can be `user_group`, `user` or `system_group`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(appAssociationTargetTypes...),
+				},
+			},
+			"target_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the user group, user or system group to grant access to the application",
+				MarkdownDescription: "The ID of the user group, user or system group to grant access to the application",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"org_id": orgIDResourceAttribute(),
+		},
+	}
+}
+
+// Create associates the target with the application and sets the initial Terraform state.
+func (r *jcAppAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan AppAssociationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	// Associate the target with the app
+	tflog.Info(ctx, fmt.Sprintf("ADDING %s %s TO APP %s", plan.TargetType.ValueString(), plan.TargetID.ValueString(), plan.AppID.ValueString()))
+	err := modifyGraphConnection(ctx, r.client, graphAssociationsPath("application", plan.AppID.ValueString()), "add", plan.TargetType.ValueString(), plan.TargetID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Associating Application",
+			"Could not associate "+plan.TargetType.ValueString()+" "+plan.TargetID.ValueString()+" with App ID "+plan.AppID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	plan.ID = types.StringValue(plan.AppID.ValueString() + "/" + plan.TargetType.ValueString() + "/" + plan.TargetID.ValueString())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jcAppAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state AppAssociationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Get the app associations of this target type
+	targetIds, err := getGraphAssociationIDs(ctx, r.client, "application", state.AppID.ValueString(), state.TargetType.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Reading Jumpcloud App Associations",
+			"Could not read associations of Jumpcloud App ID "+state.AppID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if !slices.Contains(targetIds, state.TargetID.ValueString()) {
+		// The association or the application was removed outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(state.AppID.ValueString() + "/" + state.TargetType.ValueString() + "/" + state.TargetID.ValueString())
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called because every attribute requires replacement.
+func (r *jcAppAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan AppAssociationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the association and removes the Terraform state on success.
+func (r *jcAppAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state AppAssociationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Disassociate only this target from the app
+	tflog.Info(ctx, fmt.Sprintf("REMOVING %s %s FROM APP %s", state.TargetType.ValueString(), state.TargetID.ValueString(), state.AppID.ValueString()))
+	err := modifyGraphConnection(ctx, r.client, graphAssociationsPath("application", state.AppID.ValueString()), "remove", state.TargetType.ValueString(), state.TargetID.ValueString(), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Removing Application Association",
+			"Could not remove "+state.TargetType.ValueString()+" "+state.TargetID.ValueString()+" from App ID "+state.AppID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jcAppAssociationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of resource
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource state from an app_id/target_type/target_id composite ID, optionally prefixed with org_id:.
+func (r *jcAppAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	_, id := importOrgID(ctx, req, resp)
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[2] == "" || !slices.Contains(appAssociationTargetTypes, parts[1]) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: [org_id:]app_id/target_type/target_id where target_type is one of %s. Got: %q",
+				strings.Join(appAssociationTargetTypes, ", "), req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_type"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_id"), parts[2])...)
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceAppAssociation_AssociateGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Grant a new group access to the first application
+				Config: providerConfig + `data "jumpcloud_apps" "all" {}
+										resource "jumpcloud_usergroup" "app_group" {
+											name        = "app_association_terraform_test"
+											description = "This group made via terraform test"
+										}
+										resource "jumpcloud_app_association" "association" {
+											app_id      = data.jumpcloud_apps.all.apps[0].id
+											target_type = "user_group"
+											target_id   = jumpcloud_usergroup.app_group.id
+										}`,
+				// Compose multiple test checks to verify the resource
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpcloud_app_association.association",
+						"target_type",
+						"user_group"),
+					resource.TestCheckResourceAttrPair("jumpcloud_app_association.association",
+						"target_id",
+						"jumpcloud_usergroup.app_group",
+						"id"),
+				),
+			},
+			{
+				// Import the association by app_id/target_type/target_id
+				ResourceName:      "jumpcloud_app_association.association",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
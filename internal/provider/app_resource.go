@@ -5,9 +5,13 @@ import (
 	"fmt"
 	"github.com/Spotnana-Tech/sec-jumpcloud-client-go"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"slices"
@@ -36,7 +40,75 @@ type AppSchemaModel struct {
 	Name             types.String `tfsdk:"name"`
 	DisplayName      types.String `tfsdk:"display_name"`
 	DisplayLabel     types.String `tfsdk:"display_label"`
+	SsoType          types.String `tfsdk:"sso_type"`
+	LogoURL          types.String `tfsdk:"logo_url"`
+	SsoURL           types.String `tfsdk:"sso_url"`
+	AcsURL           types.String `tfsdk:"acs_url"`
+	SpEntityID       types.String `tfsdk:"sp_entity_id"`
+	IdpEntityID      types.String `tfsdk:"idp_entity_id"`
+	NameIDFormat     types.String `tfsdk:"name_id_format"`
+	SignAssertion    types.Bool   `tfsdk:"sign_assertion"`
+	SignResponse     types.Bool   `tfsdk:"sign_response"`
 	AssociatedGroups types.Set    `tfsdk:"associated_groups"`
+	OrgID            types.String `tfsdk:"org_id"`
+}
+
+// applyTo copies the configured application settings onto a v1 application object.
+// Unknown values are left alone so the template or current settings are kept.
+func (m AppSchemaModel) applyTo(app map[string]any) {
+	setString := func(v types.String, set func(string)) {
+		if !v.IsNull() && !v.IsUnknown() {
+			set(v.ValueString())
+		}
+	}
+	setBool := func(v types.Bool, set func(bool)) {
+		if !v.IsNull() && !v.IsUnknown() {
+			set(v.ValueBool())
+		}
+	}
+	setString(m.DisplayLabel, func(v string) { app["displayLabel"] = v })
+	setString(m.LogoURL, func(v string) { app["logo"] = map[string]any{"url": v} })
+	setString(m.SsoURL, func(v string) { app["ssoUrl"] = v })
+	setString(m.AcsURL, func(v string) { setAppConfigValue(app, "acsUrl", v) })
+	setString(m.SpEntityID, func(v string) { setAppConfigValue(app, "spEntityId", v) })
+	setString(m.IdpEntityID, func(v string) { setAppConfigValue(app, "idpEntityId", v) })
+	setString(m.NameIDFormat, func(v string) { setAppConfigValue(app, "nameIdFormat", v) })
+	setBool(m.SignAssertion, func(v bool) { setAppConfigValue(app, "signAssertion", v) })
+	setBool(m.SignResponse, func(v bool) { setAppConfigValue(app, "signResponse", v) })
+}
+
+// settingsChanged reports whether any setting stored on the v1 application differs between m and state.
+func (m AppSchemaModel) settingsChanged(state AppSchemaModel) bool {
+	return !m.DisplayLabel.Equal(state.DisplayLabel) ||
+		!m.LogoURL.Equal(state.LogoURL) ||
+		!m.SsoURL.Equal(state.SsoURL) ||
+		!m.AcsURL.Equal(state.AcsURL) ||
+		!m.SpEntityID.Equal(state.SpEntityID) ||
+		!m.IdpEntityID.Equal(state.IdpEntityID) ||
+		!m.NameIDFormat.Equal(state.NameIDFormat) ||
+		!m.SignAssertion.Equal(state.SignAssertion) ||
+		!m.SignResponse.Equal(state.SignResponse)
+}
+
+// setSettings fills the SSO settings of m from a v1 application object.
+func (m *AppSchemaModel) setSettings(app map[string]any) {
+	stringValue := func(v any) types.String {
+		s, _ := v.(string)
+		return types.StringValue(s)
+	}
+	boolValue := func(v any) types.Bool {
+		b, _ := v.(bool)
+		return types.BoolValue(b)
+	}
+	logo, _ := app["logo"].(map[string]any)
+	m.LogoURL = stringValue(logo["url"])
+	m.SsoURL = stringValue(app["ssoUrl"])
+	m.AcsURL = stringValue(appConfigValue(app, "acsUrl"))
+	m.SpEntityID = stringValue(appConfigValue(app, "spEntityId"))
+	m.IdpEntityID = stringValue(appConfigValue(app, "idpEntityId"))
+	m.NameIDFormat = stringValue(appConfigValue(app, "nameIdFormat"))
+	m.SignAssertion = boolValue(appConfigValue(app, "signAssertion"))
+	m.SignResponse = boolValue(appConfigValue(app, "signResponse"))
 }
 
 // Metadata returns the resource type name.
@@ -47,20 +119,124 @@ func (r *jcAppResource) Metadata(_ context.Context, req resource.MetadataRequest
 // Schema defines the schema for the resource.
 func (r *jcAppResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Description: "Manages a JumpCloud application. Destroying the resource deletes the application in JumpCloud, " +
+			"use a removed block or terraform state rm to stop managing an application without deleting it.",
+		MarkdownDescription: "Manages a JumpCloud application.\n\n" +
+			"~> **Note:** Destroying the resource, or removing it from the configuration, deletes the application and its SSO settings in JumpCloud. " +
+			"Earlier versions only removed it from the Terraform state. To stop managing an application without deleting it, " +
+			"use a [`removed`](https://developer.hashicorp.com/terraform/language/resources/syntax#removing-resources) block with `destroy = false` " +
+			"or `terraform state rm`.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"name": schema.StringAttribute{
-				Optional: true,
-				Computed: true,
+				Optional:            true,
+				Computed:            true,
+				Description:         "The application catalog template to create the app from, ex. custom-saml-app or custom-oidc-app. Required to create an app",
+				MarkdownDescription: "The application catalog template to create the app from, ex. `custom-saml-app` or `custom-oidc-app`. Required to create an app",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
 			},
 			"display_name": schema.StringAttribute{
 				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 			"display_label": schema.StringAttribute{
-				Optional: true,
-				Computed: true,
+				Optional:            true,
+				Computed:            true,
+				Description:         "The label shown to users in the user portal",
+				MarkdownDescription: "The label shown to users in the user portal",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sso_type": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The SSO protocol of the app, ex. saml or oidc",
+				MarkdownDescription: "The SSO protocol of the app, ex. `saml` or `oidc`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"logo_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "URL of the logo shown in the user portal",
+				MarkdownDescription: "URL of the logo shown in the user portal",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sso_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The JumpCloud IdP URL users are sent to for IdP-initiated login",
+				MarkdownDescription: "The JumpCloud IdP URL users are sent to for IdP-initiated login",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"acs_url": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The service provider Assertion Consumer Service URL",
+				MarkdownDescription: "The service provider Assertion Consumer Service URL",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sp_entity_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The service provider entity ID",
+				MarkdownDescription: "The service provider entity ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"idp_entity_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The JumpCloud IdP entity ID",
+				MarkdownDescription: "The JumpCloud IdP entity ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name_id_format": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The SAML NameID format, ex. urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress",
+				MarkdownDescription: "The SAML NameID format, ex. `urn:oasis:names:tc:SAML:1.1:nameid-format:emailAddress`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sign_assertion": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Sign the SAML assertion",
+				MarkdownDescription: "Sign the SAML assertion",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sign_response": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Sign the SAML response",
+				MarkdownDescription: "Sign the SAML response",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
 			},
 			// Set attribute does not care about order
 			"associated_groups": schema.SetAttribute{
@@ -70,13 +246,96 @@ func (r *jcAppResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Description:         "Group IDs associated with this app",
 				MarkdownDescription: "This is a set of group IDs associated with this app.",
 			},
+			"org_id": orgIDResourceAttribute(),
 		},
 	}
 }
 
 // Create creates the resource and sets the initial Terraform state.
 func (r *jcAppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	// We should not be Creating or Deleting Apps via TF provider... yet
+	// Retrieve values from plan
+	var plan AppSchemaModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	if plan.Name.IsNull() || plan.Name.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Missing Application Template",
+			"name must be set to an application catalog template, ex. custom-saml-app, to create an app.",
+		)
+		return
+	}
+
+	// Start from the catalog template so its default settings are kept
+	newApp, err := getApplicationTemplate(ctx, r.client, plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("name"),
+			"Error Reading Application Template",
+			"Could not read application template "+plan.Name.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	for _, key := range []string{"_id", "id", "isConfigured", "jit"} {
+		delete(newApp, key)
+	}
+	newApp["name"] = plan.Name.ValueString()
+	plan.applyTo(newApp)
+
+	// Create the app
+	app, err := createApplicationV1(ctx, r.client, newApp)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Jumpcloud App",
+			"Could not create app, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	appId, _ := app["_id"].(string)
+	tflog.Info(ctx, fmt.Sprintf("Created App ID: %s %s", appId, plan.Name.ValueString()))
+
+	// Associate the planned groups with the app
+	if !plan.AssociatedGroups.IsUnknown() {
+		var groups []string
+		diags = plan.AssociatedGroups.ElementsAs(ctx, &groups, false)
+		resp.Diagnostics.Append(diags...)
+		for _, group := range groups {
+			tflog.Info(ctx, fmt.Sprintf("ADDING GROUPID %s TO %s \n", group, appId))
+			err = modifyGraphConnection(ctx, r.client, graphAssociationsPath("application", appId), "add", "user_group", group, nil)
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Associating Application",
+					"Could not associate group "+group+" with App ID "+appId+": "+err.Error(),
+				)
+			}
+		}
+	}
+
+	// Read back the app, including settings filled in by JumpCloud
+	state, found, diags := r.readState(ctx, appId)
+	resp.Diagnostics.Append(diags...)
+	if !found && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.AddError(
+			"Error Reading Jumpcloud App",
+			"Could not find newly created Jumpcloud App ID "+appId,
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		// Keep the ID in state so the app is not orphaned
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), appId)...)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), plan.OrgID)...)
+		return
+	}
+	state.OrgID = plan.OrgID
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -88,27 +347,67 @@ func (r *jcAppResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	r.client = withOrg(r.client, state.OrgID)
 
-	// Get the app by ID
+	// Overwrite items with refreshed state
 	tflog.Info(ctx, fmt.Sprintf("Looking Up App ID: %s %s", state.ID.ValueString(), state.Name.ValueString()))
-	app, err := r.client.GetApplication(state.ID.ValueString())
+	orgID := state.OrgID
+	state, found, diags := r.readState(ctx, state.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !found {
+		// The app was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	state.OrgID = orgID
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state) //nolint:all
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// readState returns the current app, its SSO settings and its group associations.
+// found is false when the app does not exist.
+func (r *jcAppResource) readState(ctx context.Context, appId string) (state AppSchemaModel, found bool, diags diag.Diagnostics) {
+	// Get the app by ID
+	app, err := r.client.GetApplication(appId)
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Error Reading Jumpcloud App",
-			"Could not read Jumpcloud App ID "+state.ID.ValueString()+": "+err.Error(),
+			"Could not read Jumpcloud App ID "+appId+": "+err.Error(),
 		)
-		return
+		return state, false, diags
+	}
+	if app.ID == "" {
+		return state, false, diags
 	}
 	tflog.Info(ctx, fmt.Sprintf("Look Up Results: %s %s", app.ID, app.DisplayName))
+
+	// Get the SSO settings, bookmark apps have none
+	appV1, err := getApplicationV1(ctx, r.client, appId)
+	if err != nil && !isNotFound(err) {
+		diags.AddError(
+			"Error Reading Jumpcloud App",
+			"Could not read settings of Jumpcloud App ID "+appId+": "+err.Error(),
+		)
+		return state, false, diags
+	}
+
 	// Get the app associations
-	associations, err := r.client.GetAppAssociations(state.ID.ValueString(), "user_group")
+	associations, err := r.client.GetAppAssociations(appId, "user_group")
 	tflog.Info(ctx, fmt.Sprintf("Associations: %s", associations))
 	if err != nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Error Reading Jumpcloud Group",
-			"Could not read Jumpcloud Group ID "+state.ID.ValueString()+": "+err.Error(),
+			"Could not read Jumpcloud Group ID "+appId+": "+err.Error(),
 		)
-		return
+		return state, false, diags
 	}
 
 	// A temp holder for associations
@@ -118,27 +417,24 @@ func (r *jcAppResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		_id := a.To.ID // App ID
 		idAssociations = append(idAssociations, types.StringValue(_id))
 	}
-	appAssociations, diags := types.SetValue(types.StringType, idAssociations)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	appAssociations, d := types.SetValue(types.StringType, idAssociations)
+	diags.Append(d...)
 
-	// Overwrite items with refreshed state
 	state = AppSchemaModel{
 		ID:               types.StringValue(app.ID),
 		Name:             types.StringValue(app.Name),
 		DisplayName:      types.StringValue(app.DisplayName),
 		DisplayLabel:     types.StringValue(app.DisplayLabel),
+		SsoType:          types.StringValue(""),
 		AssociatedGroups: appAssociations,
 	}
-
-	// Set refreshed state
-	diags = resp.State.Set(ctx, &state) //nolint:all
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+	if app.Sso != nil {
+		state.SsoType = types.StringValue(app.Sso.Type)
 	}
+	if appV1 != nil {
+		state.setSettings(appV1)
+	}
+	return state, true, diags
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -151,6 +447,23 @@ func (r *jcAppResource) Update(ctx context.Context, req resource.UpdateRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Update the SSO settings, keeping everything this resource does not manage
+	if plan.settingsChanged(state) {
+		app, err := getApplicationV1(ctx, r.client, state.ID.ValueString())
+		if err == nil {
+			plan.applyTo(app)
+			_, err = updateApplicationV1(ctx, r.client, state.ID.ValueString(), app)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Jumpcloud App",
+				"Could not update Jumpcloud App ID "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
 
 	// Turning stated and planned associated groups into sets for use in comparison
 	oldstate, _ := state.AssociatedGroups.ToSetValue(ctx)
@@ -184,6 +497,14 @@ func (r *jcAppResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	// When associated_groups is omitted, associations are left to jumpcloud_app_association resources
+	var configGroups types.Set
+	diags = req.Config.GetAttribute(ctx, path.Root("associated_groups"), &configGroups)
+	resp.Diagnostics.Append(diags...)
+	if configGroups.IsNull() {
+		oldElements, newElements = nil, nil
+	}
+
 	// For each group in our new configuration
 	for _, group := range newElements {
 
@@ -227,13 +548,8 @@ func (r *jcAppResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	// Overwrite items with refreshed state
-	state = AppSchemaModel{
-		ID:               types.StringValue(state.ID.ValueString()),
-		Name:             types.StringValue(state.Name.ValueString()),
-		DisplayName:      types.StringValue(state.DisplayName.ValueString()),
-		DisplayLabel:     types.StringValue(state.DisplayLabel.ValueString()),
-		AssociatedGroups: appAssociations,
-	}
+	plan.AssociatedGroups = appAssociations
+	state = plan
 
 	// Set refreshed state
 	diags = resp.State.Set(ctx, &state)
@@ -246,7 +562,25 @@ func (r *jcAppResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *jcAppResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	// We should not be Creating or Deleting Apps via TF provider... yet
+	// Retrieve values from state
+	var state AppSchemaModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Delete the app, its associations go with it
+	tflog.Info(ctx, fmt.Sprintf("Deleting App ID: %s %s", state.ID.ValueString(), state.DisplayLabel.ValueString()))
+	err := deleteApplicationV1(ctx, r.client, state.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Jumpcloud App",
+			"Could not delete Jumpcloud App ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
 }
 
 // Configure adds the provider configuration to the resource.
@@ -269,8 +603,9 @@ func (r *jcAppResource) Configure(ctx context.Context, req resource.ConfigureReq
 	r.client = client
 }
 
-// ImportState imports the resource state from an existing resource.
+// ImportState imports the resource state from an existing resource, optionally prefixed with org_id:.
 func (r *jcAppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	_, id := importOrgID(ctx, req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceApp_CreateSamlApp(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create a custom SAML app from the catalog template
+				Config: providerConfig + `resource "jumpcloud_app" "saml" {
+											name          = "custom-saml-app"
+											display_label = "terraform_test_saml_app"
+											sso_url       = "https://sso.jumpcloud.com/saml2/terraform-test"
+											acs_url       = "https://example.com/saml/acs"
+											sp_entity_id  = "https://example.com"
+											idp_entity_id = "https://example.com/jumpcloud"
+											sign_assertion = true
+										}`,
+				// Compose multiple test checks to verify the resource
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("jumpcloud_app.saml", "id"),
+					resource.TestCheckResourceAttr("jumpcloud_app.saml", "display_label", "terraform_test_saml_app"),
+					resource.TestCheckResourceAttr("jumpcloud_app.saml", "acs_url", "https://example.com/saml/acs"),
+					resource.TestCheckResourceAttr("jumpcloud_app.saml", "sign_assertion", "true"),
+				),
+			},
+			{
+				// Update the settings in place
+				Config: providerConfig + `resource "jumpcloud_app" "saml" {
+											name          = "custom-saml-app"
+											display_label = "terraform_test_saml_app_renamed"
+											sso_url       = "https://sso.jumpcloud.com/saml2/terraform-test"
+											acs_url       = "https://example.com/saml/consume"
+											sp_entity_id  = "https://example.com"
+											idp_entity_id = "https://example.com/jumpcloud"
+											sign_assertion = false
+										}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpcloud_app.saml", "display_label", "terraform_test_saml_app_renamed"),
+					resource.TestCheckResourceAttr("jumpcloud_app.saml", "acs_url", "https://example.com/saml/consume"),
+					resource.TestCheckResourceAttr("jumpcloud_app.saml", "sign_assertion", "false"),
+				),
+			},
+			{
+				ResourceName:      "jumpcloud_app.saml",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
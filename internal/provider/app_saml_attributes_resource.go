@@ -0,0 +1,396 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &jcAppSAMLAttributesResource{}
+	_ resource.ResourceWithConfigure      = &jcAppSAMLAttributesResource{}
+	_ resource.ResourceWithImportState    = &jcAppSAMLAttributesResource{}
+	_ resource.ResourceWithValidateConfig = &jcAppSAMLAttributesResource{}
+)
+
+// NewAppSAMLAttributesResource is a helper function to simplify the provider implementation.
+func NewAppSAMLAttributesResource() resource.Resource {
+	return &jcAppSAMLAttributesResource{}
+}
+
+// jcAppSAMLAttributesResource is the resource implementation.
+// It is authoritative for the attribute statements of one SAML application and leaves its other settings alone.
+type jcAppSAMLAttributesResource struct {
+	client *jumpcloud.Client
+}
+
+// AppSAMLAttributesResourceModel is the local model for this resource type.
+type AppSAMLAttributesResourceModel struct {
+	ID                    types.String            `tfsdk:"id"`
+	AppID                 types.String            `tfsdk:"app_id"`
+	Attributes            []AppSAMLAttributeModel `tfsdk:"attribute"`
+	IncludeGroupAttribute types.Bool              `tfsdk:"include_group_attribute"`
+	GroupAttributeName    types.String            `tfsdk:"group_attribute_name"`
+	OrgID                 types.String            `tfsdk:"org_id"`
+}
+
+// AppSAMLAttributeModel is an attribute block of the resource.
+type AppSAMLAttributeModel struct {
+	Name          types.String `tfsdk:"name"`
+	UserAttribute types.String `tfsdk:"user_attribute"`
+	ConstantValue types.String `tfsdk:"constant_value"`
+	NameFormat    types.String `tfsdk:"name_format"`
+}
+
+// Metadata returns the resource type name.
+func (r *jcAppSAMLAttributesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_saml_attributes"
+}
+
+// Schema defines the schema for the resource.
+func (r *jcAppSAMLAttributesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages every SAML attribute statement of a JumpCloud application. Statements not declared here are removed.",
+		MarkdownDescription: "Manages every SAML attribute statement of a JumpCloud application. Statements not declared here are removed.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Same as app_id",
+				MarkdownDescription: "Same as `app_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "Application ID",
+				MarkdownDescription: "Application ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"include_group_attribute": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Send the names of the user's groups in the group attribute. Leave unset to leave the group attribute unmanaged",
+				MarkdownDescription: "Send the names of the user's groups in the group attribute. Leave unset to leave the group attribute unmanaged",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_attribute_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The name of the group attribute expected by the service provider, ex. memberOf. Leave unset to keep the current name",
+				MarkdownDescription: "The name of the group attribute expected by the service provider, ex. `memberOf`. Leave unset to keep the current name",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": orgIDResourceAttribute(),
+		},
+		Blocks: map[string]schema.Block{
+			// Set block does not care about order
+			"attribute": schema.SetNestedBlock{
+				Description:         "A SAML attribute statement sent to the service provider",
+				MarkdownDescription: "A SAML attribute statement sent to the service provider",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:            true,
+							Description:         "The attribute name expected by the service provider, ex. emailAddress",
+							MarkdownDescription: "The attribute name expected by the service provider, ex. `emailAddress`",
+						},
+						"user_attribute": schema.StringAttribute{
+							Optional:            true,
+							Description:         "The JumpCloud user attribute to send, ex. email. Conflicts with constant_value",
+							MarkdownDescription: "The JumpCloud user attribute to send, ex. `email`. Conflicts with `constant_value`",
+						},
+						"constant_value": schema.StringAttribute{
+							Optional:            true,
+							Description:         "A fixed value to send. Conflicts with user_attribute",
+							MarkdownDescription: "A fixed value to send. Conflicts with `user_attribute`",
+						},
+						"name_format": schema.StringAttribute{
+							Optional:            true,
+							Description:         "The SAML NameFormat of the attribute, ex. urn:oasis:names:tc:SAML:2.0:attrname-format:basic",
+							MarkdownDescription: "The SAML NameFormat of the attribute, ex. `urn:oasis:names:tc:SAML:2.0:attrname-format:basic`",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that every attribute has exactly one value source and a unique name.
+func (r *jcAppSAMLAttributesResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config AppSAMLAttributesResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	names := map[string]bool{}
+	for _, attribute := range config.Attributes {
+		if attribute.Name.IsUnknown() || attribute.UserAttribute.IsUnknown() || attribute.ConstantValue.IsUnknown() {
+			continue
+		}
+		name := attribute.Name.ValueString()
+		if attribute.UserAttribute.IsNull() == attribute.ConstantValue.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("attribute"),
+				"Invalid SAML Attribute",
+				"Attribute "+name+" must set exactly one of user_attribute or constant_value.",
+			)
+		}
+		if names[name] {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("attribute"),
+				"Duplicate SAML Attribute",
+				"Attribute "+name+" is declared more than once.",
+			)
+		}
+		names[name] = true
+	}
+}
+
+// Create sets the attribute statements of the app and sets the initial Terraform state.
+func (r *jcAppSAMLAttributesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan AppSAMLAttributesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	app, diags := r.write(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = plan.AppID
+	plan.setGroupAttribute(app)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jcAppSAMLAttributesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state AppSAMLAttributesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Get the app settings by ID
+	app, err := getApplicationV1(ctx, r.client, state.AppID.ValueString())
+	if isNotFound(err) {
+		// The app was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Jumpcloud App",
+			"Could not read Jumpcloud App ID "+state.AppID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite the attributes with the statements currently on the app
+	state.ID = state.AppID
+	state.Attributes = newAppSAMLAttributeModels(app)
+	state.IncludeGroupAttribute = types.BoolNull()
+	state.GroupAttributeName = types.StringNull()
+	state.setGroupAttribute(app)
+	tflog.Info(ctx, fmt.Sprintf("App ID %s has %d SAML attributes", state.AppID.ValueString(), len(state.Attributes)))
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update replaces the attribute statements of the app and sets the updated Terraform state on success.
+func (r *jcAppSAMLAttributesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan AppSAMLAttributesResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	app, diags := r.write(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = plan.AppID
+	plan.setGroupAttribute(app)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes every attribute statement from the app and removes the Terraform state on success.
+func (r *jcAppSAMLAttributesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state AppSAMLAttributesResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Nothing to clean up when the app itself is gone
+	if _, err := getApplicationV1(ctx, r.client, state.AppID.ValueString()); isNotFound(err) {
+		return
+	}
+
+	// The group attribute is left as it is
+	state.Attributes = nil
+	state.IncludeGroupAttribute = types.BoolNull()
+	state.GroupAttributeName = types.StringNull()
+	_, diags = r.write(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// write replaces the attribute statements of an app and sets its group attribute when it is known,
+// keeping all of its other settings. It returns the updated app.
+func (r *jcAppSAMLAttributesResource) write(ctx context.Context, plan AppSAMLAttributesResourceModel) (app map[string]any, diags diag.Diagnostics) {
+	appId, attributes := plan.AppID.ValueString(), plan.Attributes
+	app, err := getApplicationV1(ctx, r.client, appId)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Jumpcloud App",
+			"Could not read Jumpcloud App ID "+appId+": "+err.Error(),
+		)
+		return nil, diags
+	}
+
+	var constants, mapped []samlAttribute
+	for _, attribute := range attributes {
+		a := samlAttribute{
+			Name:       attribute.Name.ValueString(),
+			NameFormat: attribute.NameFormat.ValueString(),
+		}
+		if attribute.ConstantValue.IsNull() {
+			a.Value = attribute.UserAttribute.ValueString()
+			mapped = append(mapped, a)
+		} else {
+			a.Value = attribute.ConstantValue.ValueString()
+			constants = append(constants, a)
+		}
+	}
+	setAppSAMLAttributes(app, samlConstantAttributesKey, constants)
+	setAppSAMLAttributes(app, samlDatabaseAttributesKey, mapped)
+	if !plan.IncludeGroupAttribute.IsNull() && !plan.IncludeGroupAttribute.IsUnknown() {
+		setAppConfigValue(app, samlIncludeGroupAttributeKey, plan.IncludeGroupAttribute.ValueBool())
+	}
+	if !plan.GroupAttributeName.IsNull() && !plan.GroupAttributeName.IsUnknown() {
+		setAppConfigValue(app, samlGroupAttributeNameKey, plan.GroupAttributeName.ValueString())
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Setting %d SAML attributes on App ID %s", len(attributes), appId))
+	if _, err = updateApplicationV1(ctx, r.client, appId, app); err != nil {
+		diags.AddError(
+			"Error Updating Jumpcloud App",
+			"Could not update SAML attributes of Jumpcloud App ID "+appId+": "+err.Error(),
+		)
+		return nil, diags
+	}
+	return app, diags
+}
+
+// setGroupAttribute fills the group attribute settings that are not set from the app, a missing setting is
+// not included and has no name.
+func (m *AppSAMLAttributesResourceModel) setGroupAttribute(app map[string]any) {
+	if m.IncludeGroupAttribute.IsNull() || m.IncludeGroupAttribute.IsUnknown() {
+		include, _ := appConfigValue(app, samlIncludeGroupAttributeKey).(bool)
+		m.IncludeGroupAttribute = types.BoolValue(include)
+	}
+	if m.GroupAttributeName.IsNull() || m.GroupAttributeName.IsUnknown() {
+		name, _ := appConfigValue(app, samlGroupAttributeNameKey).(string)
+		m.GroupAttributeName = types.StringValue(name)
+	}
+}
+
+// newAppSAMLAttributeModels converts the attribute statements of a v1 application to attribute blocks.
+func newAppSAMLAttributeModels(app map[string]any) []AppSAMLAttributeModel {
+	var models []AppSAMLAttributeModel
+	optional := func(s string) types.String {
+		if s == "" {
+			return types.StringNull()
+		}
+		return types.StringValue(s)
+	}
+	for _, a := range appSAMLAttributes(app, samlConstantAttributesKey) {
+		models = append(models, AppSAMLAttributeModel{
+			Name:          types.StringValue(a.Name),
+			UserAttribute: types.StringNull(),
+			ConstantValue: types.StringValue(a.Value),
+			NameFormat:    optional(a.NameFormat),
+		})
+	}
+	for _, a := range appSAMLAttributes(app, samlDatabaseAttributesKey) {
+		models = append(models, AppSAMLAttributeModel{
+			Name:          types.StringValue(a.Name),
+			UserAttribute: types.StringValue(a.Value),
+			ConstantValue: types.StringNull(),
+			NameFormat:    optional(a.NameFormat),
+		})
+	}
+	sort.Slice(models, func(i, j int) bool {
+		return models[i].Name.ValueString() < models[j].Name.ValueString()
+	})
+	return models
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jcAppSAMLAttributesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of resource
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource state from an application ID, optionally prefixed with org_id:.
+func (r *jcAppSAMLAttributesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	_, id := importOrgID(ctx, req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), id)...)
+}
@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceAppSAMLAttributes_SetAttributes(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Map a user attribute and a constant on a new SAML app
+				Config: providerConfig + `resource "jumpcloud_app" "saml" {
+											name          = "custom-saml-app"
+											display_label = "terraform_test_saml_attributes"
+										}
+										resource "jumpcloud_app_saml_attributes" "attributes" {
+											app_id                  = jumpcloud_app.saml.id
+											include_group_attribute = true
+											group_attribute_name    = "memberOf"
+											attribute {
+												name           = "emailAddress"
+												user_attribute = "email"
+											}
+											attribute {
+												name           = "team"
+												constant_value = "engineering"
+											}
+										}`,
+				// Compose multiple test checks to verify the resource
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpcloud_app_saml_attributes.attributes", "attribute.#", "2"),
+					resource.TestCheckResourceAttr("jumpcloud_app_saml_attributes.attributes", "include_group_attribute", "true"),
+					resource.TestCheckResourceAttr("jumpcloud_app_saml_attributes.attributes", "group_attribute_name", "memberOf"),
+					resource.TestCheckTypeSetElemNestedAttrs("jumpcloud_app_saml_attributes.attributes", "attribute.*", map[string]string{
+						"name":           "emailAddress",
+						"user_attribute": "email",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("jumpcloud_app_saml_attributes.attributes", "attribute.*", map[string]string{
+						"name":           "team",
+						"constant_value": "engineering",
+					}),
+				),
+			},
+			{
+				// Import the attributes by app ID
+				ResourceName:      "jumpcloud_app_saml_attributes.attributes",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &jcAppSAMLMetadataDataSource{}
+	_ datasource.DataSourceWithConfigure        = &jcAppSAMLMetadataDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &jcAppSAMLMetadataDataSource{}
+)
+
+// jcAppSAMLMetadataDataSourceModel maps the data source schema data.
+type jcAppSAMLMetadataDataSourceModel struct {
+	AppID                 types.String `tfsdk:"app_id"`
+	DisplayLabel          types.String `tfsdk:"display_label"`
+	IdpEntityID           types.String `tfsdk:"idp_entity_id"`
+	SsoURL                types.String `tfsdk:"sso_url"`
+	SloURL                types.String `tfsdk:"slo_url"`
+	Certificate           types.String `tfsdk:"certificate"`
+	CertificateExpiration types.String `tfsdk:"certificate_expiration"`
+	MetadataXML           types.String `tfsdk:"metadata_xml"`
+	OrgID                 types.String `tfsdk:"org_id"`
+}
+
+// NewjcAppSAMLMetadataDataSource is a helper function to simplify the provider implementation.
+func NewjcAppSAMLMetadataDataSource() datasource.DataSource {
+	return &jcAppSAMLMetadataDataSource{}
+}
+
+// jcAppSAMLMetadataDataSource is the data source implementation.
+// This struct accepts a client pointer to the JumpCloud Go client so terraform can make its changes to the system.
+type jcAppSAMLMetadataDataSource struct {
+	client *jumpcloud.Client
+}
+
+// Metadata returns the data source type name.
+func (d *jcAppSAMLMetadataDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_saml_metadata"
+}
+
+// Schema defines the schema for the data source.
+func (d *jcAppSAMLMetadataDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "The JumpCloud IdP details of a SAML application, for configuring the service provider side",
+		MarkdownDescription: "The JumpCloud IdP details of a SAML application, for configuring the service provider side",
+		Attributes: map[string]schema.Attribute{
+			"app_id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the application. Conflicts with display_label",
+				MarkdownDescription: "The ID of the application. Conflicts with `display_label`",
+			},
+			"display_label": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The exact display label of the application. Conflicts with app_id",
+				MarkdownDescription: "The exact display label of the application. Conflicts with `app_id`",
+			},
+			"idp_entity_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The JumpCloud IdP entity ID",
+				MarkdownDescription: "The JumpCloud IdP entity ID",
+			},
+			"sso_url": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The JumpCloud single sign-on URL",
+				MarkdownDescription: "The JumpCloud single sign-on URL",
+			},
+			"slo_url": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The JumpCloud single logout URL, empty when single logout is not configured",
+				MarkdownDescription: "The JumpCloud single logout URL, empty when single logout is not configured",
+			},
+			"certificate": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The PEM encoded IdP signing certificate",
+				MarkdownDescription: "The PEM encoded IdP signing certificate",
+			},
+			"certificate_expiration": schema.StringAttribute{
+				Computed:            true,
+				Description:         "When the IdP signing certificate expires, in RFC 3339 format",
+				MarkdownDescription: "When the IdP signing certificate expires, in RFC 3339 format",
+			},
+			"metadata_xml": schema.StringAttribute{
+				Computed:            true,
+				Description:         "A SAML 2.0 IdP metadata document generated by the provider from the SSO settings of the app: the IdP entity ID, certificate, SSO and SLO URLs and the name_id_format of the app. It is not the metadata file exported from the JumpCloud console",
+				MarkdownDescription: "A SAML 2.0 IdP metadata document generated by the provider from the SSO settings of the app: the IdP entity ID, certificate, SSO and SLO URLs and the `name_id_format` of the app. It is not the metadata file exported from the JumpCloud console",
+			},
+			"org_id": orgIDDataSourceAttribute(),
+		},
+	}
+}
+
+// ConfigValidators requires exactly one way of selecting the application.
+func (d *jcAppSAMLMetadataDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("app_id"),
+			path.MatchRoot("display_label"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *jcAppSAMLMetadataDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state jcAppSAMLMetadataDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = withOrg(d.client, state.OrgID)
+
+	// Look up the app ID from the display label
+	appId := state.AppID.ValueString()
+	if appId == "" {
+		apps, err := d.client.GetAllApplications()
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Jumpcloud Apps",
+				err.Error(),
+			)
+			return
+		}
+		var matches []string
+		for _, app := range apps {
+			if app.DisplayLabel == state.DisplayLabel.ValueString() {
+				matches = append(matches, app.ID)
+			}
+		}
+		if len(matches) != 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("display_label"),
+				"Unable to Find Jumpcloud App",
+				fmt.Sprintf("Expected one app with display label %q, found %d.", state.DisplayLabel.ValueString(), len(matches)),
+			)
+			return
+		}
+		appId = matches[0]
+	}
+
+	// Get the SSO settings of the app
+	tflog.Info(ctx, fmt.Sprintf("Reading SAML metadata of App ID: %s", appId))
+	app, err := getApplicationV1(ctx, d.client, appId)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Jumpcloud App",
+			"Could not read Jumpcloud App ID "+appId+": "+err.Error(),
+		)
+		return
+	}
+
+	entityId, _ := appConfigValue(app, "idpEntityId").(string)
+	ssoUrl, _ := app["ssoUrl"].(string)
+	sloUrl, _ := appConfigValue(app, "sloUrl").(string)
+	rawCert, _ := appConfigValue(app, "idpCertificate").(string)
+	cert, err := parseCertificate(rawCert)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read IdP Certificate",
+			"Could not parse the IdP certificate of Jumpcloud App ID "+appId+": "+err.Error(),
+		)
+		return
+	}
+	nameIdFormat, _ := appConfigValue(app, "nameIdFormat").(string)
+	metadata, err := samlIdPMetadata(entityId, ssoUrl, sloUrl, nameIdFormat, cert)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Build SAML Metadata",
+			err.Error(),
+		)
+		return
+	}
+
+	// Map response to state
+	displayLabel, _ := app["displayLabel"].(string)
+	state = jcAppSAMLMetadataDataSourceModel{
+		AppID:                 types.StringValue(appId),
+		DisplayLabel:          types.StringValue(displayLabel),
+		IdpEntityID:           types.StringValue(entityId),
+		SsoURL:                types.StringValue(ssoUrl),
+		SloURL:                types.StringValue(sloUrl),
+		Certificate:           types.StringValue(string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw}))),
+		CertificateExpiration: types.StringValue(cert.NotAfter.UTC().Format(time.RFC3339)),
+		MetadataXML:           types.StringValue(metadata),
+		OrgID:                 state.OrgID,
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// parseCertificate parses a certificate that is either PEM encoded or bare base64 DER.
+func parseCertificate(s string) (*x509.Certificate, error) {
+	if block, _ := pem.Decode([]byte(s)); block != nil {
+		return x509.ParseCertificate(block.Bytes)
+	}
+	der, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+	if err != nil {
+		return nil, fmt.Errorf("certificate is neither PEM nor base64: %w", err)
+	}
+	return x509.ParseCertificate(der)
+}
+
+// samlEntityDescriptor is the subset of the SAML 2.0 metadata schema needed to describe an IdP.
+type samlEntityDescriptor struct {
+	XMLName  xml.Name `xml:"urn:oasis:names:tc:SAML:2.0:metadata EntityDescriptor"`
+	EntityID string   `xml:"entityID,attr"`
+	IDP      struct {
+		WantAuthnRequestsSigned    bool   `xml:"WantAuthnRequestsSigned,attr"`
+		ProtocolSupportEnumeration string `xml:"protocolSupportEnumeration,attr"`
+		KeyDescriptor              struct {
+			Use     string      `xml:"use,attr"`
+			KeyInfo samlKeyInfo `xml:"KeyInfo"`
+		} `xml:"KeyDescriptor"`
+		SingleLogoutService []samlEndpoint `xml:"SingleLogoutService,omitempty"`
+		NameIDFormat        string         `xml:"NameIDFormat"`
+		SingleSignOnService []samlEndpoint `xml:"SingleSignOnService"`
+	} `xml:"IDPSSODescriptor"`
+}
+
+// samlKeyInfo is an XML signature KeyInfo holding a base64 DER certificate.
+type samlKeyInfo struct {
+	XMLName     xml.Name `xml:"http://www.w3.org/2000/09/xmldsig# KeyInfo"`
+	Certificate string   `xml:"X509Data>X509Certificate"`
+}
+
+// samlEndpoint is a SAML service endpoint.
+type samlEndpoint struct {
+	Binding  string `xml:"Binding,attr"`
+	Location string `xml:"Location,attr"`
+}
+
+// samlIdPMetadata renders the SAML 2.0 IdP metadata document of an application from its SSO settings.
+// An application without a NameID format sends the unspecified format.
+func samlIdPMetadata(entityId, ssoUrl, sloUrl, nameIdFormat string, cert *x509.Certificate) (string, error) {
+	const (
+		httpPost     = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-POST"
+		httpRedirect = "urn:oasis:names:tc:SAML:2.0:bindings:HTTP-Redirect"
+	)
+	var descriptor samlEntityDescriptor
+	descriptor.EntityID = entityId
+	descriptor.IDP.ProtocolSupportEnumeration = "urn:oasis:names:tc:SAML:2.0:protocol"
+	descriptor.IDP.KeyDescriptor.Use = "signing"
+	descriptor.IDP.KeyDescriptor.KeyInfo.Certificate = base64.StdEncoding.EncodeToString(cert.Raw)
+	if sloUrl != "" {
+		descriptor.IDP.SingleLogoutService = []samlEndpoint{{Binding: httpRedirect, Location: sloUrl}}
+	}
+	descriptor.IDP.NameIDFormat = nameIdFormat
+	if nameIdFormat == "" {
+		descriptor.IDP.NameIDFormat = "urn:oasis:names:tc:SAML:1.1:nameid-format:unspecified"
+	}
+	descriptor.IDP.SingleSignOnService = []samlEndpoint{
+		{Binding: httpPost, Location: ssoUrl},
+		{Binding: httpRedirect, Location: ssoUrl},
+	}
+	out, err := xml.MarshalIndent(descriptor, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return xml.Header + string(out) + "\n", nil
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *jcAppSAMLMetadataDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of data source
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceAppSAMLMetadata(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Read the IdP metadata of a new SAML app
+				Config: providerConfig + `resource "jumpcloud_app" "saml" {
+											name          = "custom-saml-app"
+											display_label = "terraform_test_saml_metadata"
+										}
+										data "jumpcloud_app_saml_metadata" "metadata" {
+											app_id = jumpcloud_app.saml.id
+										}`,
+				// Compose multiple test checks to verify the data source
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jumpcloud_app_saml_metadata.metadata", "display_label", "terraform_test_saml_metadata"),
+					resource.TestMatchResourceAttr("data.jumpcloud_app_saml_metadata.metadata", "certificate", regexp.MustCompile(`^-----BEGIN CERTIFICATE-----`)),
+					resource.TestCheckResourceAttrSet("data.jumpcloud_app_saml_metadata.metadata", "certificate_expiration"),
+					resource.TestMatchResourceAttr("data.jumpcloud_app_saml_metadata.metadata", "metadata_xml", regexp.MustCompile(`<EntityDescriptor`)),
+				),
+			},
+		},
+	})
+}
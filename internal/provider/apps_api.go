@@ -0,0 +1,141 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+)
+
+// applicationTemplateList is the v1 list response for application templates.
+type applicationTemplateList struct {
+	TotalCount int              `json:"totalCount"`
+	Results    []map[string]any `json:"results"`
+}
+
+// Applications are handled as raw JSON objects on the v1 API so that settings this provider
+// does not manage (certificates, private keys, template defaults) survive a read-modify-write.
+
+// getApplicationTemplate returns the catalog template with the given name.
+func getApplicationTemplate(ctx context.Context, c *jumpcloud.Client, name string) (map[string]any, error) {
+	var result applicationTemplateList
+	params := url.Values{
+		"filter": {"name:$eq:" + name},
+		"limit":  {"1"},
+	}
+	_, err := jcRequest(ctx, c, http.MethodGet, "/api/application-templates", params, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Results) == 0 {
+		return nil, fmt.Errorf("no application template found with name %q", name)
+	}
+	return result.Results[0], nil
+}
+
+// getApplicationV1 returns an application, including its SSO configuration, by ID.
+func getApplicationV1(ctx context.Context, c *jumpcloud.Client, appId string) (app map[string]any, err error) {
+	_, err = jcRequest(ctx, c, http.MethodGet, "/api/applications/"+appId, nil, nil, &app)
+	return app, err
+}
+
+// createApplicationV1 creates a new application.
+func createApplicationV1(ctx context.Context, c *jumpcloud.Client, newApp map[string]any) (app map[string]any, err error) {
+	_, err = jcRequest(ctx, c, http.MethodPost, "/api/applications", nil, newApp, &app)
+	return app, err
+}
+
+// updateApplicationV1 replaces an application.
+func updateApplicationV1(ctx context.Context, c *jumpcloud.Client, appId string, updatedApp map[string]any) (app map[string]any, err error) {
+	_, err = jcRequest(ctx, c, http.MethodPut, "/api/applications/"+appId, nil, updatedApp, &app)
+	return app, err
+}
+
+// deleteApplicationV1 deletes an application.
+func deleteApplicationV1(ctx context.Context, c *jumpcloud.Client, appId string) error {
+	_, err := jcRequest(ctx, c, http.MethodDelete, "/api/applications/"+appId, nil, nil, nil)
+	return err
+}
+
+// appConfigValue returns config.<key>.value of a v1 application, or nil if it is not set.
+func appConfigValue(app map[string]any, key string) any {
+	config, _ := app["config"].(map[string]any)
+	field, _ := config[key].(map[string]any)
+	return field["value"]
+}
+
+// setAppConfigValue sets config.<key>.value of a v1 application, keeping the field's other properties.
+func setAppConfigValue(app map[string]any, key string, value any) {
+	config, ok := app["config"].(map[string]any)
+	if !ok {
+		config = map[string]any{}
+		app["config"] = config
+	}
+	field, ok := config[key].(map[string]any)
+	if !ok {
+		field = map[string]any{}
+		config[key] = field
+	}
+	field["value"] = value
+}
+
+// SAML application config keys holding the attribute statements.
+// Constant attributes have a fixed value, database attributes map a JumpCloud user attribute.
+const (
+	samlConstantAttributesKey = "constantAttributes"
+	samlDatabaseAttributesKey = "databaseAttributes"
+)
+
+// SAML application config keys of the group attribute, which sends the names of the user's groups
+// in an attribute statement when it is included.
+const (
+	samlIncludeGroupAttributeKey = "includeGroupAttribute"
+	samlGroupAttributeNameKey    = "groupAttributeName"
+)
+
+// samlAttribute is an attribute statement of a SAML application.
+type samlAttribute struct {
+	Name       string
+	Value      string
+	NameFormat string
+}
+
+// appSAMLAttributes returns the attribute statements stored under a config key of a v1 application.
+func appSAMLAttributes(app map[string]any, key string) []samlAttribute {
+	var attributes []samlAttribute
+	entries, _ := appConfigValue(app, key).([]any)
+	for _, entry := range entries {
+		fields, ok := entry.(map[string]any)
+		if !ok {
+			continue
+		}
+		name, _ := fields["name"].(string)
+		value, _ := fields["value"].(string)
+		nameFormat, _ := fields["nameFormat"].(string)
+		attributes = append(attributes, samlAttribute{Name: name, Value: value, NameFormat: nameFormat})
+	}
+	return attributes
+}
+
+// setAppSAMLAttributes replaces the attribute statements stored under a config key of a v1 application.
+func setAppSAMLAttributes(app map[string]any, key string, attributes []samlAttribute) {
+	entries := []any{}
+	for _, attribute := range attributes {
+		entry := map[string]any{
+			"name":  attribute.Name,
+			"value": attribute.Value,
+		}
+		if attribute.NameFormat != "" {
+			entry["nameFormat"] = attribute.NameFormat
+		}
+		if key == samlConstantAttributesKey {
+			entry["readOnly"] = false
+			entry["required"] = false
+			entry["visible"] = true
+		}
+		entries = append(entries, entry)
+	}
+	setAppConfigValue(app, key, entries)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/Spotnana-Tech/sec-jumpcloud-client-go"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -31,7 +32,8 @@ type jcAppsModel struct {
 // jcAppsDataSourceModel maps the data source schema data.
 // TODO: Update this struct value to types.ListType or types.SetType if the data source returns a list or set of items.
 type jcAppsDataSourceModel struct {
-	Apps []jcAppsModel `tfsdk:"apps"`
+	Apps  []jcAppsModel `tfsdk:"apps"`
+	OrgID types.String  `tfsdk:"org_id"`
 }
 
 // NewjcAppsDataSource is a helper function to simplify the provider implementation.
@@ -96,6 +98,7 @@ func (d *jcAppsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 					},
 				},
 			},
+			"org_id": orgIDDataSourceAttribute(),
 		},
 	}
 }
@@ -104,6 +107,11 @@ func (d *jcAppsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 func (d *jcAppsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get all user groups
 	var state jcAppsDataSourceModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("org_id"), &state.OrgID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = withOrg(d.client, state.OrgID)
 	apps, err := d.client.GetAllApplications()
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -0,0 +1,433 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &jcCommandResource{}
+	_ resource.ResourceWithConfigure      = &jcCommandResource{}
+	_ resource.ResourceWithImportState    = &jcCommandResource{}
+	_ resource.ResourceWithValidateConfig = &jcCommandResource{}
+)
+
+// commandLaunchScheduled is the launch_type of commands the API calls repeated.
+const commandLaunchScheduled = "scheduled"
+
+// NewCommandResource is a helper function to simplify the provider implementation.
+func NewCommandResource() resource.Resource {
+	return &jcCommandResource{}
+}
+
+// jcCommandResource is the resource implementation.
+type jcCommandResource struct {
+	client *jumpcloud.Client
+}
+
+// CommandResourceModel is the local model for this resource type.
+type CommandResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Command        types.String `tfsdk:"command"`
+	CommandType    types.String `tfsdk:"command_type"`
+	Shell          types.String `tfsdk:"shell"`
+	User           types.String `tfsdk:"user"`
+	Timeout        types.Int64  `tfsdk:"timeout"`
+	LaunchType     types.String `tfsdk:"launch_type"`
+	Schedule       types.String `tfsdk:"schedule"`
+	Trigger        types.String `tfsdk:"trigger"`
+	Files          types.Set    `tfsdk:"files"`
+	SystemIDs      types.Set    `tfsdk:"system_ids"`
+	SystemGroupIDs types.Set    `tfsdk:"system_group_ids"`
+	OrgID          types.String `tfsdk:"org_id"`
+}
+
+// toCommand converts the model to the v1 command object.
+func (m CommandResourceModel) toCommand(ctx context.Context) (cmd command, diags diag.Diagnostics) {
+	cmd = command{
+		Name:        m.Name.ValueString(),
+		Command:     m.Command.ValueString(),
+		CommandType: m.CommandType.ValueString(),
+		Shell:       m.Shell.ValueString(),
+		User:        m.User.ValueString(),
+		Timeout:     strconv.FormatInt(m.Timeout.ValueInt64(), 10),
+		LaunchType:  m.LaunchType.ValueString(),
+		Schedule:    m.Schedule.ValueString(),
+		Trigger:     m.Trigger.ValueString(),
+		Files:       []string{},
+	}
+	if cmd.LaunchType == commandLaunchScheduled {
+		cmd.LaunchType = commandLaunchRepeated
+		cmd.ScheduleRepeatType = "custom"
+	}
+	diags.Append(m.Files.ElementsAs(ctx, &cmd.Files, false)...)
+	return cmd, diags
+}
+
+// newCommandResourceModel maps a v1 command object to the model.
+func newCommandResourceModel(ctx context.Context, cmd command) (m CommandResourceModel, diags diag.Diagnostics) {
+	m = CommandResourceModel{
+		ID:          types.StringValue(cmd.ID),
+		Name:        types.StringValue(cmd.Name),
+		Command:     types.StringValue(cmd.Command),
+		CommandType: types.StringValue(cmd.CommandType),
+		Shell:       types.StringValue(cmd.Shell),
+		User:        types.StringValue(cmd.User),
+		LaunchType:  types.StringValue(cmd.LaunchType),
+		Schedule:    types.StringValue(cmd.Schedule),
+		Trigger:     types.StringValue(cmd.Trigger),
+	}
+	if cmd.LaunchType == commandLaunchRepeated {
+		m.LaunchType = types.StringValue(commandLaunchScheduled)
+	}
+	// The timeout is a string of seconds, empty when never set
+	timeout, err := strconv.ParseInt(cmd.Timeout, 10, 64)
+	if err != nil && cmd.Timeout != "" {
+		diags.AddError(
+			"Error Reading Jumpcloud Command",
+			"Unexpected timeout "+strconv.Quote(cmd.Timeout)+" on command "+cmd.ID,
+		)
+	}
+	m.Timeout = types.Int64Value(timeout)
+	if cmd.Files == nil {
+		cmd.Files = []string{}
+	}
+	files, d := types.SetValueFrom(ctx, types.StringType, cmd.Files)
+	diags.Append(d...)
+	m.Files = files
+	return m, diags
+}
+
+// Metadata returns the resource type name.
+func (r *jcCommandResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_command"
+}
+
+// Schema defines the schema for the resource.
+func (r *jcCommandResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Command ID",
+				MarkdownDescription: "Command ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Command Name",
+				MarkdownDescription: "Command Name",
+			},
+			"command": schema.StringAttribute{
+				Required:            true,
+				Description:         "The body of the command. Use file() to keep the script in its own file",
+				MarkdownDescription: "The body of the command. Use `file()` to keep the script in its own file",
+			},
+			"command_type": schema.StringAttribute{
+				Required:            true,
+				Description:         "The OS the command runs on, can be linux, mac or windows",
+				MarkdownDescription: "The OS the command runs on, can be `linux`, `mac` or `windows`",
+				Validators: []validator.String{
+					stringvalidator.OneOf("linux", "mac", "windows"),
+				},
+			},
+			"shell": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The shell running the command, ex. powershell or cmd on windows",
+				MarkdownDescription: "The shell running the command, ex. `powershell` or `cmd` on windows",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The ID of the user the command runs as, 000000000000000000000000 runs as root on linux and mac",
+				MarkdownDescription: "The ID of the user the command runs as, `000000000000000000000000` runs as root on linux and mac",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"timeout": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(120),
+				Description:         "Seconds the command may run before it is stopped",
+				MarkdownDescription: "Seconds the command may run before it is stopped",
+			},
+			"launch_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(commandLaunchManual),
+				Description:         "How the command is started, can be manual, trigger or scheduled",
+				MarkdownDescription: "How the command is started, can be `manual`, `trigger` or `scheduled`",
+				Validators: []validator.String{
+					stringvalidator.OneOf(commandLaunchManual, commandLaunchTrigger, commandLaunchScheduled),
+				},
+			},
+			"schedule": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "Cron expression of a scheduled command, ex. 0 3 * * *",
+				MarkdownDescription: "Cron expression of a `scheduled` command, ex. `0 3 * * *`",
+			},
+			"trigger": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "Name of the webhook trigger of a trigger command",
+				MarkdownDescription: "Name of the webhook trigger of a `trigger` command",
+			},
+			// Set attribute does not care about order
+			"files": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+				Description:         "IDs of the files uploaded with the command",
+				MarkdownDescription: "IDs of the files uploaded with the command",
+			},
+			"system_ids":       systemTargetSchema("system", "the command runs on", "jumpcloud_graph_association"),
+			"system_group_ids": systemTargetSchema("system_group", "the command runs on", "jumpcloud_graph_association"),
+			"org_id":           orgIDResourceAttribute(),
+		},
+	}
+}
+
+// ValidateConfig checks that scheduled and trigger commands say when they run.
+func (r *jcCommandResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config CommandResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch config.LaunchType.ValueString() {
+	case commandLaunchScheduled:
+		if config.Schedule.IsNull() || (!config.Schedule.IsUnknown() && config.Schedule.ValueString() == "") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("schedule"),
+				"Missing Command Schedule",
+				"A scheduled command needs a schedule.",
+			)
+		}
+	case commandLaunchTrigger:
+		if config.Trigger.IsNull() || (!config.Trigger.IsUnknown() && config.Trigger.ValueString() == "") {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("trigger"),
+				"Missing Command Trigger",
+				"A trigger command needs a trigger name.",
+			)
+		}
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *jcCommandResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan CommandResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	newCommand, diags := plan.toCommand(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new command, check for errors
+	cmd, err := createCommand(ctx, r.client, newCommand)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating command",
+			"Could not create command, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Created Jumpcloud Command: %s", cmd.Name))
+
+	// Save the ID right away so a failure below does not orphan the command
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), cmd.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), plan.OrgID)...)
+
+	// Bind the command to its systems
+	resp.Diagnostics.Append(setSystemTargets(ctx, r.client, req.Config, "command", cmd.ID, plan.SystemIDs, plan.SystemGroupIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state, diags := newCommandResourceModel(ctx, cmd)
+	resp.Diagnostics.Append(diags...)
+	state.OrgID = plan.OrgID
+	state.SystemIDs, state.SystemGroupIDs, diags = readSystemTargets(ctx, r.client, "command", cmd.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jcCommandResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state CommandResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Get refreshed command value from JumpCloud
+	tflog.Info(ctx, fmt.Sprintf("Looking Up Command ID: %s", state.ID.ValueString()))
+	cmd, err := getCommand(ctx, r.client, state.ID.ValueString())
+	if isNotFound(err) {
+		// The command was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Jumpcloud Command",
+			"Could not read Jumpcloud Command ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	newState, diags := newCommandResourceModel(ctx, cmd)
+	resp.Diagnostics.Append(diags...)
+	newState.OrgID = state.OrgID
+	newState.SystemIDs, newState.SystemGroupIDs, diags = readSystemTargets(ctx, r.client, "command", cmd.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *jcCommandResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state CommandResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	updatedCommand, diags := plan.toCommand(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update command, reference the state's command Id
+	cmd, err := updateCommand(ctx, r.client, state.ID.ValueString(), updatedCommand)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Modifying Command",
+			"Could not modify Command ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Reconcile bindings that are set in the configuration
+	resp.Diagnostics.Append(setSystemTargets(ctx, r.client, req.Config, "command", cmd.ID, plan.SystemIDs, plan.SystemGroupIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	newState, diags := newCommandResourceModel(ctx, cmd)
+	resp.Diagnostics.Append(diags...)
+	newState.OrgID = plan.OrgID
+	newState.SystemIDs, newState.SystemGroupIDs, diags = readSystemTargets(ctx, r.client, "command", cmd.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *jcCommandResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state CommandResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Delete existing command. This object will be purged from the state file so there is no need to return values
+	err := deleteCommand(ctx, r.client, state.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Command",
+			"Could not delete command, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jcCommandResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of resource
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource state from live resources via their ID attribute, optionally prefixed with org_id:.
+func (r *jcCommandResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	_, id := importOrgID(ctx, req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceCommand_CreateCommand(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create a manual command
+				Config: providerConfig + `resource "jumpcloud_command" "command" {
+											name         = "command_terraform_test"
+											command_type = "linux"
+											command      = "echo hello"
+										}`,
+				// Compose multiple test checks to verify the resource
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpcloud_command.command", "command", "echo hello"),
+					resource.TestCheckResourceAttr("jumpcloud_command.command", "launch_type", "manual"),
+					resource.TestCheckResourceAttr("jumpcloud_command.command", "timeout", "120"),
+					resource.TestCheckResourceAttrSet("jumpcloud_command.command", "id"),
+				),
+			},
+			{
+				// Change the body and schedule the command
+				Config: providerConfig + `resource "jumpcloud_command" "command" {
+											name         = "command_terraform_test"
+											command_type = "linux"
+											command      = "echo goodbye"
+											timeout      = 300
+											launch_type  = "scheduled"
+											schedule     = "0 3 * * *"
+										}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpcloud_command.command", "command", "echo goodbye"),
+					resource.TestCheckResourceAttr("jumpcloud_command.command", "launch_type", "scheduled"),
+					resource.TestCheckResourceAttr("jumpcloud_command.command", "schedule", "0 3 * * *"),
+					resource.TestCheckResourceAttr("jumpcloud_command.command", "timeout", "300"),
+				),
+			},
+			{
+				ResourceName:      "jumpcloud_command.command",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
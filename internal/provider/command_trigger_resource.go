@@ -0,0 +1,213 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &jcCommandTriggerResource{}
+	_ resource.ResourceWithConfigure      = &jcCommandTriggerResource{}
+	_ resource.ResourceWithValidateConfig = &jcCommandTriggerResource{}
+)
+
+// NewCommandTriggerResource is a helper function to simplify the provider implementation.
+func NewCommandTriggerResource() resource.Resource {
+	return &jcCommandTriggerResource{}
+}
+
+// jcCommandTriggerResource is the resource implementation.
+// It fires the trigger when created or replaced and has nothing to read back or delete in JumpCloud.
+type jcCommandTriggerResource struct {
+	client *jumpcloud.Client
+}
+
+// CommandTriggerResourceModel is the local model for this resource type.
+type CommandTriggerResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	Trigger             types.String `tfsdk:"trigger"`
+	Payload             types.String `tfsdk:"payload"`
+	Triggers            types.Map    `tfsdk:"triggers"`
+	JobID               types.String `tfsdk:"job_id"`
+	TriggeredCommandIDs types.List   `tfsdk:"triggered_command_ids"`
+	OrgID               types.String `tfsdk:"org_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *jcCommandTriggerResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_command_trigger"
+}
+
+// Schema defines the schema for the resource.
+func (r *jcCommandTriggerResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The job ID, or the trigger name when the API does not return one",
+				MarkdownDescription: "The job ID, or the trigger name when the API does not return one",
+			},
+			"trigger": schema.StringAttribute{
+				Required:            true,
+				Description:         "The trigger name of the commands to run, see the trigger attribute of jumpcloud_command",
+				MarkdownDescription: "The trigger name of the commands to run, see the `trigger` attribute of `jumpcloud_command`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"payload": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("{}"),
+				Description:         "JSON object posted to the trigger, its keys are available to the commands as variables. Changing it runs the commands again",
+				MarkdownDescription: "JSON object posted to the trigger, its keys are available to the commands as variables. Changing it runs the commands again",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Description:         "Arbitrary values that run the commands again when they change, ex. the ID of a policy",
+				MarkdownDescription: "Arbitrary values that run the commands again when they change, ex. the ID of a policy",
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"job_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the job started by the trigger, empty when the API does not return one",
+				MarkdownDescription: "The ID of the job started by the trigger, empty when the API does not return one",
+			},
+			"triggered_command_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				Description:         "IDs of the commands that were run",
+				MarkdownDescription: "IDs of the commands that were run",
+			},
+			"org_id": orgIDResourceAttribute(),
+		},
+	}
+}
+
+// ValidateConfig checks that the payload is a JSON object.
+func (r *jcCommandTriggerResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config CommandTriggerResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.Payload.IsNull() || config.Payload.IsUnknown() {
+		return
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal([]byte(config.Payload.ValueString()), &payload); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("payload"),
+			"Invalid Trigger Payload",
+			"The payload must be a JSON object: "+err.Error(),
+		)
+	}
+}
+
+// Create posts to the trigger and sets the initial Terraform state.
+func (r *jcCommandTriggerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan CommandTriggerResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	payload := map[string]any{}
+	if err := json.Unmarshal([]byte(plan.Payload.ValueString()), &payload); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("payload"),
+			"Invalid Trigger Payload",
+			"The payload must be a JSON object: "+err.Error(),
+		)
+		return
+	}
+
+	// Fire the trigger, check for errors
+	trigger := plan.Trigger.ValueString()
+	result, err := triggerCommand(ctx, r.client, trigger, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Triggering Commands",
+			"Could not trigger "+trigger+", unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Triggered %s: %v", trigger, result.Triggered))
+
+	// Map response body to schema and populate Computed attribute values
+	jobId := result.JobID
+	if jobId == "" {
+		jobId = result.WorkflowInstanceID
+	}
+	plan.JobID = types.StringValue(jobId)
+	plan.ID = plan.JobID
+	if jobId == "" {
+		plan.ID = plan.Trigger
+	}
+	if result.Triggered == nil {
+		result.Triggered = []string{}
+	}
+	plan.TriggeredCommandIDs, diags = types.ListValueFrom(ctx, types.StringType, result.Triggered)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read keeps the state as is, a finished trigger has nothing to refresh.
+func (r *jcCommandTriggerResource) Read(_ context.Context, _ resource.ReadRequest, _ *resource.ReadResponse) {
+}
+
+// Update is never called because every configurable attribute requires replacement.
+func (r *jcCommandTriggerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan CommandTriggerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete only removes the Terraform state, commands that already ran cannot be undone.
+func (r *jcCommandTriggerResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jcCommandTriggerResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of resource
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
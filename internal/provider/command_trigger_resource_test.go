@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceCommandTrigger_Trigger(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Run a trigger command bound to a system group
+				Config: providerConfig + `resource "jumpcloud_system_group" "systems" {
+											name = "command_trigger_terraform_test"
+										}
+										resource "jumpcloud_command" "command" {
+											name             = "command_trigger_terraform_test"
+											command_type     = "linux"
+											command          = "echo $message"
+											launch_type      = "trigger"
+											trigger          = "command-trigger-terraform-test"
+											system_group_ids = [jumpcloud_system_group.systems.id]
+										}
+										resource "jumpcloud_command_trigger" "run" {
+											trigger = jumpcloud_command.command.trigger
+											payload = jsonencode({ message = "hello" })
+										}`,
+				// Compose multiple test checks to verify the resource
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckTypeSetElemAttrPair("jumpcloud_command.command", "system_group_ids.*", "jumpcloud_system_group.systems", "id"),
+					resource.TestCheckResourceAttr("jumpcloud_command_trigger.run", "triggered_command_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("jumpcloud_command_trigger.run", "triggered_command_ids.0", "jumpcloud_command.command", "id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,68 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+)
+
+// Launch types of a command.
+const (
+	commandLaunchManual   = "manual"
+	commandLaunchTrigger  = "trigger"
+	commandLaunchRepeated = "repeated"
+)
+
+// command is the structure of a v1 Command object.
+type command struct {
+	ID                 string   `json:"_id,omitempty"`
+	Name               string   `json:"name"`
+	Command            string   `json:"command"`
+	CommandType        string   `json:"commandType"`
+	Shell              string   `json:"shell,omitempty"`
+	User               string   `json:"user,omitempty"`
+	Timeout            string   `json:"timeout"`
+	LaunchType         string   `json:"launchType"`
+	Schedule           string   `json:"schedule"`
+	ScheduleRepeatType string   `json:"scheduleRepeatType,omitempty"`
+	Trigger            string   `json:"trigger"`
+	Files              []string `json:"files"`
+}
+
+// getCommand returns a command by ID.
+func getCommand(ctx context.Context, c *jumpcloud.Client, commandId string) (cmd command, err error) {
+	_, err = jcRequest(ctx, c, http.MethodGet, "/api/commands/"+commandId, nil, nil, &cmd)
+	return cmd, err
+}
+
+// createCommand creates a new command.
+func createCommand(ctx context.Context, c *jumpcloud.Client, newCommand command) (cmd command, err error) {
+	_, err = jcRequest(ctx, c, http.MethodPost, "/api/commands", nil, newCommand, &cmd)
+	return cmd, err
+}
+
+// updateCommand replaces a command.
+func updateCommand(ctx context.Context, c *jumpcloud.Client, commandId string, updatedCommand command) (cmd command, err error) {
+	_, err = jcRequest(ctx, c, http.MethodPut, "/api/commands/"+commandId, nil, updatedCommand, &cmd)
+	return cmd, err
+}
+
+// deleteCommand deletes a command.
+func deleteCommand(ctx context.Context, c *jumpcloud.Client, commandId string) error {
+	_, err := jcRequest(ctx, c, http.MethodDelete, "/api/commands/"+commandId, nil, nil, nil)
+	return err
+}
+
+// commandTriggerResult is the response of the command trigger endpoint.
+type commandTriggerResult struct {
+	Triggered          []string `json:"triggered"`
+	JobID              string   `json:"jobId"`
+	WorkflowInstanceID string   `json:"workflowInstanceId"`
+}
+
+// triggerCommand runs the commands listening to a trigger name. The payload is exposed to the commands as variables.
+func triggerCommand(ctx context.Context, c *jumpcloud.Client, trigger string, payload map[string]any) (result commandTriggerResult, err error) {
+	_, err = jcRequest(ctx, c, http.MethodPost, "/api/command/trigger/"+trigger, nil, payload, &result)
+	return result, err
+}
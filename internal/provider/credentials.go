@@ -0,0 +1,142 @@
+package provider
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// defaultProfile is the credentials file profile used when none is configured.
+const defaultProfile = "default"
+
+// errProfileNotFound is returned when the credentials file has no section for the profile.
+var errProfileNotFound = errors.New("profile not found")
+
+// credentialsFilePath returns the location of the JumpCloud credentials file,
+// JC_CREDENTIALS_FILE or ~/.jumpcloud/credentials.
+func credentialsFilePath() (string, error) {
+	if p := os.Getenv("JC_CREDENTIALS_FILE"); p != "" {
+		return p, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".jumpcloud", "credentials"), nil
+}
+
+// readCredentialsProfile returns the settings of one profile of an INI style credentials file:
+//
+//	[default]
+//	api_key = ...
+//
+//	[staging]
+//	api_key = ...
+func readCredentialsProfile(filename, profile string) (map[string]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var settings map[string]string
+	section := ""
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";"):
+			continue
+		case strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]"):
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if section == profile && settings == nil {
+				settings = map[string]string{}
+			}
+		default:
+			key, value, ok := strings.Cut(line, "=")
+			if !ok {
+				return nil, fmt.Errorf("%s:%d: expected key = value", filename, n)
+			}
+			if section == profile {
+				settings[strings.TrimSpace(key)] = strings.TrimSpace(value)
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		return nil, fmt.Errorf("%s: %w: %s", filename, errProfileNotFound, profile)
+	}
+	return settings, nil
+}
+
+// jcCredentials authenticates the provider with either an API key or a service account.
+type jcCredentials struct {
+	apiKey       string
+	clientID     string
+	clientSecret string
+	// source names where the credentials were found, for the logs and diagnostics
+	source string
+}
+
+// resolveCredentials takes the credentials from the first of the configuration, the environment
+// and the credentials file profile that sets any of them.
+// A source setting both an API key and a service account, or half of a service account, is an error.
+func resolveCredentials(config jumpcloudProviderModel, profile map[string]string, profileSource string) (jcCredentials, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	for _, c := range []jcCredentials{
+		{
+			apiKey:       config.ApiKey.ValueString(),
+			clientID:     config.ClientID.ValueString(),
+			clientSecret: config.ClientSecret.ValueString(),
+			source:       "provider configuration",
+		},
+		{
+			apiKey:       os.Getenv("JC_API_KEY"),
+			clientID:     os.Getenv("JC_CLIENT_ID"),
+			clientSecret: os.Getenv("JC_CLIENT_SECRET"),
+			source:       "JC_API_KEY, JC_CLIENT_ID and JC_CLIENT_SECRET environment variables",
+		},
+		{
+			apiKey:       profile["api_key"],
+			clientID:     profile["client_id"],
+			clientSecret: profile["client_secret"],
+			source:       profileSource,
+		},
+	} {
+		if c.apiKey == "" && c.clientID == "" && c.clientSecret == "" {
+			continue
+		}
+		if c.apiKey != "" && (c.clientID != "" || c.clientSecret != "") {
+			diags.AddAttributeError(
+				path.Root("api_key"),
+				"Conflicting JumpCloud Credentials",
+				"The "+c.source+" set both an API key and a service account client ID or secret. "+
+					"Use either api_key, or client_id and client_secret.",
+			)
+			return c, diags
+		}
+		if c.apiKey == "" && (c.clientID == "" || c.clientSecret == "") {
+			attribute := "client_secret"
+			if c.clientID == "" {
+				attribute = "client_id"
+			}
+			diags.AddAttributeError(
+				path.Root(attribute),
+				"Incomplete JumpCloud Service Account",
+				"The "+c.source+" set only one of the service account client ID and secret. "+
+					"Both client_id and client_secret are required to authenticate as a service account.",
+			)
+			return c, diags
+		}
+		return c, diags
+	}
+	return jcCredentials{}, diags
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadCredentialsProfile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "credentials")
+	err := os.WriteFile(filename, []byte(`
+# Personal key
+[default]
+api_key = default-key
+
+; Staging organization
+[ staging ]
+api_key=staging-key
+`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for profile, want := range map[string]string{
+		"default": "default-key",
+		"staging": "staging-key",
+	} {
+		settings, err := readCredentialsProfile(filename, profile)
+		if err != nil {
+			t.Fatalf("profile %s: %v", profile, err)
+		}
+		if settings["api_key"] != want {
+			t.Errorf("profile %s: api_key = %q, want %q", profile, settings["api_key"], want)
+		}
+	}
+
+	if _, err := readCredentialsProfile(filename, "production"); !errors.Is(err, errProfileNotFound) {
+		t.Errorf("profile production: err = %v, want %v", err, errProfileNotFound)
+	}
+	if _, err := readCredentialsProfile(filepath.Join(t.TempDir(), "missing"), "default"); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("missing file: err = %v, want %v", err, os.ErrNotExist)
+	}
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// defaultRegion is the JumpCloud region used when neither api_url nor region is configured.
+const defaultRegion = "us"
+
+// regionURLs are the base URLs of the v1 and v2 APIs of each JumpCloud region.
+var regionURLs = map[string]string{
+	"us": "https://console.jumpcloud.com",
+	"eu": "https://console.eu.jumpcloud.com",
+}
+
+// regions returns the names of the JumpCloud regions.
+func regions() []string {
+	names := make([]string, 0, len(regionURLs))
+	for name := range regionURLs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parseAPIURL checks that raw is an absolute http(s) URL without a path, query or fragment.
+// The API paths are always set from the root of the host, so anything after the host would be dropped.
+func parseAPIURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return nil, fmt.Errorf("%q must use the http or https scheme", raw)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("%q has no host", raw)
+	}
+	if (u.Path != "" && u.Path != "/") || u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return nil, fmt.Errorf("%q must only have a scheme, host and port, ex. https://console.jumpcloud.com", raw)
+	}
+	u.Path = ""
+	return u, nil
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+)
+
+func TestParseAPIURL(t *testing.T) {
+	for raw, valid := range map[string]bool{
+		"https://console.eu.jumpcloud.com":  true,
+		"https://console.jumpcloud.com/":    true,
+		"http://127.0.0.1:8080":             true,
+		"console.jumpcloud.com":             false,
+		"ftp://console.jumpcloud.com":       false,
+		"https://":                          false,
+		"https://console.jumpcloud.com/api": false,
+		"https://console.jumpcloud.com?x=1": false,
+	} {
+		u, err := parseAPIURL(raw)
+		if valid && err != nil {
+			t.Errorf("%s: unexpected error: %v", raw, err)
+		}
+		if !valid && err == nil {
+			t.Errorf("%s: expected an error, got %s", raw, u)
+		}
+	}
+}
+
+func TestAPIURLRouting(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/systems/5f1b2c3d4e5f6a7b8c9d0e1f" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"_id": "5f1b2c3d4e5f6a7b8c9d0e1f", "hostname": "mock"}`))
+	}))
+	defer server.Close()
+
+	client, err := jumpcloud.NewClient("key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.HostURL, err = parseAPIURL(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s, err := getSystem(context.Background(), client, "5f1b2c3d4e5f6a7b8c9d0e1f")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Hostname != "mock" {
+		t.Errorf("hostname = %q, want mock", s.Hostname)
+	}
+}
@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+)
+
+// graphPageSize is the page size used when listing v2 graph connections.
+const graphPageSize = 100
+
+// graphPathSegments maps graph object types to their v2 API path segment.
+var graphPathSegments = map[string]string{
+	"user":             "users",
+	"user_group":       "usergroups",
+	"system":           "systems",
+	"system_group":     "systemgroups",
+	"policy":           "policies",
+	"policy_group":     "policygroups",
+	"command":          "commands",
+	"application":      "applications",
+	"radius_server":    "radiusservers",
+	"ldap_server":      "ldapservers",
+	"active_directory": "activedirectories",
+	"g_suite":          "gsuites",
+	"office_365":       "office365s",
+}
+
+// graphAssociationTargets lists the object types each graph object type can be associated with.
+var graphAssociationTargets = map[string][]string{
+	"user":             {"active_directory", "application", "g_suite", "ldap_server", "office_365", "radius_server", "system", "system_group"},
+	"user_group":       {"active_directory", "application", "g_suite", "ldap_server", "office_365", "radius_server", "system", "system_group"},
+	"system":           {"command", "policy", "policy_group", "user", "user_group"},
+	"system_group":     {"command", "policy", "policy_group", "user", "user_group"},
+	"policy":           {"system", "system_group"},
+	"policy_group":     {"system", "system_group"},
+	"command":          {"system", "system_group"},
+	"application":      {"user", "user_group"},
+	"radius_server":    {"user", "user_group"},
+	"ldap_server":      {"user", "user_group"},
+	"active_directory": {"user", "user_group"},
+	"g_suite":          {"user", "user_group"},
+	"office_365":       {"user", "user_group"},
+}
+
+// graphAssociationsPath returns the v2 associations endpoint of a graph object.
+func graphAssociationsPath(objectType, objectId string) string {
+	return "/api/v2/" + graphPathSegments[objectType] + "/" + objectId + "/associations"
+}
+
+// getGraphAssociation returns the association between two graph objects, or nil if it does not exist.
+func getGraphAssociation(ctx context.Context, c *jumpcloud.Client, fromType, fromId, toType, toId string) (*graphConnection, error) {
+	associations, err := listGraphConnections(ctx, c, graphAssociationsPath(fromType, fromId), url.Values{
+		"targets": {toType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, association := range associations {
+		if association.To.ID == toId {
+			return &association, nil
+		}
+	}
+	return nil, nil
+}
+
+// graphConnection is an edge returned by the v2 graph membership and association endpoints.
+type graphConnection struct {
+	Attributes map[string]any `json:"attributes,omitempty"`
+	To         struct {
+		ID   string `json:"id"`
+		Type string `json:"type"`
+	} `json:"to"`
+}
+
+// graphOperation is the body used to add, update or remove a v2 graph edge.
+type graphOperation struct {
+	OP         string         `json:"op"`
+	Type       string         `json:"type"`
+	ID         string         `json:"id"`
+	Attributes map[string]any `json:"attributes,omitempty"`
+}
+
+// listGraphConnections returns every edge from a v2 graph endpoint, following pagination.
+func listGraphConnections(ctx context.Context, c *jumpcloud.Client, apiPath string, query url.Values) ([]graphConnection, error) {
+	var connections []graphConnection
+	params := url.Values{}
+	for k, v := range query {
+		params[k] = v
+	}
+	params.Set("limit", strconv.Itoa(graphPageSize))
+	for skip := 0; ; skip += graphPageSize {
+		var page []graphConnection
+		params.Set("skip", strconv.Itoa(skip))
+		if _, err := jcRequest(ctx, c, http.MethodGet, apiPath, params, nil, &page); err != nil {
+			return nil, err
+		}
+		connections = append(connections, page...)
+		if len(page) < graphPageSize {
+			return connections, nil
+		}
+	}
+}
+
+// modifyGraphConnection adds, updates or removes a single v2 graph edge.
+// op is one of "add", "update" or "remove".
+func modifyGraphConnection(ctx context.Context, c *jumpcloud.Client, apiPath, op, targetType, targetId string, attributes map[string]any) error {
+	_, err := jcRequest(ctx, c, http.MethodPost, apiPath, nil, graphOperation{
+		OP:         op,
+		Type:       targetType,
+		ID:         targetId,
+		Attributes: attributes,
+	}, nil)
+	return err
+}
+
+// graphMembersPath returns the v2 members endpoint of a graph group.
+func graphMembersPath(groupType, groupId string) string {
+	return "/api/v2/" + graphPathSegments[groupType] + "/" + groupId + "/members"
+}
+
+// getGraphConnectionIDs returns the IDs of the objects connected through a v2 graph endpoint.
+func getGraphConnectionIDs(ctx context.Context, c *jumpcloud.Client, apiPath string, query url.Values) ([]string, error) {
+	connections, err := listGraphConnections(ctx, c, apiPath, query)
+	if err != nil {
+		return nil, err
+	}
+	ids := []string{}
+	for _, connection := range connections {
+		ids = append(ids, connection.To.ID)
+	}
+	return ids, nil
+}
+
+// getGraphAssociationIDs returns the IDs of the objects of toType associated with a graph object.
+func getGraphAssociationIDs(ctx context.Context, c *jumpcloud.Client, fromType, fromId, toType string) ([]string, error) {
+	return getGraphConnectionIDs(ctx, c, graphAssociationsPath(fromType, fromId), url.Values{"targets": {toType}})
+}
+
+// graphEdge is an edge wanted by setGraphConnections, with the attributes it is created with.
+type graphEdge struct {
+	ID         string
+	Attributes map[string]any
+}
+
+// graphEdges returns edges without attributes to the given object IDs.
+func graphEdges(ids []string) []graphEdge {
+	edges := []graphEdge{}
+	for _, id := range ids {
+		edges = append(edges, graphEdge{ID: id})
+	}
+	return edges
+}
+
+// setGraphConnections makes want the only edges to objects of targetType on a v2 graph endpoint,
+// the associations or the members of a graph object. When attributesMatch is set, an existing edge
+// whose attributes do not match the wanted ones is updated.
+func setGraphConnections(ctx context.Context, c *jumpcloud.Client, apiPath string, query url.Values, targetType string, want []graphEdge, attributesMatch func(want, current map[string]any) bool) error {
+	current, err := listGraphConnections(ctx, c, apiPath, query)
+	if err != nil {
+		return err
+	}
+	currentEdges := map[string]graphConnection{}
+	for _, connection := range current {
+		currentEdges[connection.To.ID] = connection
+	}
+	wantIds := map[string]bool{}
+	for _, edge := range want {
+		wantIds[edge.ID] = true
+		op := "add"
+		if connection, found := currentEdges[edge.ID]; found {
+			if attributesMatch == nil || attributesMatch(edge.Attributes, connection.Attributes) {
+				continue
+			}
+			op = "update"
+		}
+		if err := modifyGraphConnection(ctx, c, apiPath, op, targetType, edge.ID, edge.Attributes); err != nil {
+			return fmt.Errorf("%s %s %s: %w", op, targetType, edge.ID, err)
+		}
+	}
+	for _, connection := range current {
+		if !wantIds[connection.To.ID] {
+			if err := modifyGraphConnection(ctx, c, apiPath, "remove", targetType, connection.To.ID, nil); err != nil {
+				return fmt.Errorf("remove %s %s: %w", targetType, connection.To.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+// setGraphAssociations makes wantIds the only objects of toType associated with a graph object.
+func setGraphAssociations(ctx context.Context, c *jumpcloud.Client, fromType, fromId, toType string, wantIds []string) error {
+	return setGraphConnections(ctx, c, graphAssociationsPath(fromType, fromId), url.Values{"targets": {toType}}, toType, graphEdges(wantIds), nil)
+}
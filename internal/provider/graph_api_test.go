@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"testing"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+)
+
+func TestSetGraphConnections(t *testing.T) {
+	var ops []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/policygroups/group/members" {
+			http.NotFound(w, r)
+			return
+		}
+		if r.Method == http.MethodGet {
+			_, _ = w.Write([]byte(`[
+				{"to": {"id": "keep", "type": "policy"}, "attributes": {"level": 1}},
+				{"to": {"id": "change", "type": "policy"}, "attributes": {"level": 1}},
+				{"to": {"id": "stale", "type": "policy"}}
+			]`))
+			return
+		}
+		var op graphOperation
+		if err := json.NewDecoder(r.Body).Decode(&op); err != nil {
+			t.Error(err)
+		}
+		ops = append(ops, op.OP+" "+op.ID)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	client, err := jumpcloud.NewClient("key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.HostURL, err = url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	levelMatches := func(want, current map[string]any) bool {
+		return want["level"] == current["level"]
+	}
+	err = setGraphConnections(context.Background(), client, graphMembersPath("policy_group", "group"), nil, "policy", []graphEdge{
+		{ID: "keep", Attributes: map[string]any{"level": float64(1)}},
+		{ID: "change", Attributes: map[string]any{"level": float64(2)}},
+		{ID: "new", Attributes: map[string]any{"level": float64(1)}},
+	}, levelMatches)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"update change", "add new", "remove stale"}; !slices.Equal(ops, want) {
+		t.Errorf("operations = %v, want %v", ops, want)
+	}
+}
@@ -0,0 +1,371 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                   = &jcGraphAssociationResource{}
+	_ resource.ResourceWithConfigure      = &jcGraphAssociationResource{}
+	_ resource.ResourceWithImportState    = &jcGraphAssociationResource{}
+	_ resource.ResourceWithValidateConfig = &jcGraphAssociationResource{}
+)
+
+// NewGraphAssociationResource is a helper function to simplify the provider implementation.
+func NewGraphAssociationResource() resource.Resource {
+	return &jcGraphAssociationResource{}
+}
+
+// jcGraphAssociationResource is the resource implementation.
+// It manages a single edge of the JumpCloud graph between any two object types that can be associated.
+type jcGraphAssociationResource struct {
+	client *jumpcloud.Client
+}
+
+// GraphAssociationResourceModel is the local model for this resource type.
+type GraphAssociationResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	FromType   types.String `tfsdk:"from_type"`
+	FromID     types.String `tfsdk:"from_id"`
+	ToType     types.String `tfsdk:"to_type"`
+	ToID       types.String `tfsdk:"to_id"`
+	Attributes types.String `tfsdk:"attributes"`
+	OrgID      types.String `tfsdk:"org_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *jcGraphAssociationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_graph_association"
+}
+
+// Schema defines the schema for the resource.
+func (r *jcGraphAssociationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	objectTypes := graphObjectTypes()
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The association ID in the form from_type/from_id/to_type/to_id",
+				MarkdownDescription: "The association ID in the form `from_type/from_id/to_type/to_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"from_type": schema.StringAttribute{
+				Required:            true,
+				Description:         "The type of the source object, one of " + strings.Join(objectTypes, ", "),
+				MarkdownDescription: "The type of the source object, one of `" + strings.Join(objectTypes, "`, `") + "`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(objectTypes...),
+				},
+			},
+			"from_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the source object",
+				MarkdownDescription: "The ID of the source object",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"to_type": schema.StringAttribute{
+				Required:            true,
+				Description:         "The type of the target object, must be a legal target of from_type",
+				MarkdownDescription: "The type of the target object, must be a legal target of `from_type`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(objectTypes...),
+				},
+			},
+			"to_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the target object",
+				MarkdownDescription: "The ID of the target object",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"attributes": schema.StringAttribute{
+				Optional:            true,
+				Description:         "JSON encoded edge attributes, ex. {\"sudo\": {\"enabled\": true, \"withoutPassword\": false}}. Only the configured keys are checked for drift, leave unset to leave the edge attributes unmanaged",
+				MarkdownDescription: "JSON encoded edge attributes, ex. `jsonencode({ sudo = { enabled = true, withoutPassword = false } })`. Only the configured keys are checked for drift, leave unset to leave the edge attributes unmanaged",
+			},
+			"org_id": orgIDResourceAttribute(),
+		},
+	}
+}
+
+// ValidateConfig checks that the two object types can be associated.
+func (r *jcGraphAssociationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config GraphAssociationResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !config.FromType.IsUnknown() && !config.FromType.IsNull() && !config.ToType.IsUnknown() && !config.ToType.IsNull() {
+		fromType, toType := config.FromType.ValueString(), config.ToType.ValueString()
+		if targets, ok := graphAssociationTargets[fromType]; ok && !slices.Contains(targets, toType) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("to_type"),
+				"Illegal Graph Association",
+				fmt.Sprintf("A %s cannot be associated with a %s. Legal targets of %s are: %s.", fromType, toType, fromType, strings.Join(targets, ", ")),
+			)
+		}
+	}
+
+	if !config.Attributes.IsUnknown() && !config.Attributes.IsNull() {
+		if _, err := parseGraphAttributes(config.Attributes.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("attributes"),
+				"Invalid Edge Attributes",
+				"attributes must be a JSON object: "+err.Error(),
+			)
+		}
+	}
+}
+
+// Create creates the association and sets the initial Terraform state.
+func (r *jcGraphAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan GraphAssociationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	resp.Diagnostics.Append(r.modify(ctx, "add", plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(plan.id())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jcGraphAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state GraphAssociationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Look up the edge from the source object
+	association, err := getGraphAssociation(ctx, r.client,
+		state.FromType.ValueString(), state.FromID.ValueString(), state.ToType.ValueString(), state.ToID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Reading Jumpcloud Graph Association",
+			"Could not read association "+state.id()+": "+err.Error(),
+		)
+		return
+	}
+	if association == nil {
+		// The edge or one of its objects was removed outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Only replace the configured JSON when the attributes really differ
+	state.ID = types.StringValue(state.id())
+	state.Attributes = refreshGraphAttributes(state.Attributes, association.Attributes)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the edge attributes and sets the updated Terraform state on success.
+func (r *jcGraphAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan GraphAssociationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	resp.Diagnostics.Append(r.modify(ctx, "update", plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.ID = types.StringValue(plan.id())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the association and removes the Terraform state on success.
+func (r *jcGraphAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state GraphAssociationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	resp.Diagnostics.Append(r.modify(ctx, "remove", state)...)
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jcGraphAssociationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of resource
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource state from a from_type/from_id/to_type/to_id composite ID, optionally prefixed with org_id:.
+func (r *jcGraphAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	_, id := importOrgID(ctx, req, resp)
+	parts := strings.Split(id, "/")
+	if len(parts) != 4 || !slices.Contains(graphAssociationTargets[parts[0]], parts[2]) || parts[1] == "" || parts[3] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: [org_id:]from_type/from_id/to_type/to_id for two types that can be associated. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("from_type"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("from_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("to_type"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("to_id"), parts[3])...)
+}
+
+// modify sends a single graph operation for the association described by m.
+func (r *jcGraphAssociationResource) modify(ctx context.Context, op string, m GraphAssociationResourceModel) (diags diag.Diagnostics) {
+	attributes, err := parseGraphAttributes(m.Attributes.ValueString())
+	if err != nil {
+		diags.AddError("Invalid Edge Attributes", "attributes must be a JSON object: "+err.Error())
+		return diags
+	}
+	if op == "remove" {
+		attributes = nil
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("Graph association %s: %s", op, m.id()))
+	err = modifyGraphConnection(ctx, r.client, graphAssociationsPath(m.FromType.ValueString(), m.FromID.ValueString()),
+		op, m.ToType.ValueString(), m.ToID.ValueString(), attributes)
+	if err != nil && !(op == "remove" && isNotFound(err)) {
+		diags.AddError(
+			"Error Modifying Jumpcloud Graph Association",
+			"Could not "+op+" association "+m.id()+": "+err.Error(),
+		)
+	}
+	return diags
+}
+
+// id returns the composite ID of the association.
+func (m GraphAssociationResourceModel) id() string {
+	return m.FromType.ValueString() + "/" + m.FromID.ValueString() + "/" + m.ToType.ValueString() + "/" + m.ToID.ValueString()
+}
+
+// graphObjectTypes returns the graph object types that have associations, sorted.
+func graphObjectTypes() []string {
+	objectTypes := make([]string, 0, len(graphAssociationTargets))
+	for objectType := range graphAssociationTargets {
+		objectTypes = append(objectTypes, objectType)
+	}
+	sort.Strings(objectTypes)
+	return objectTypes
+}
+
+// parseGraphAttributes decodes JSON edge attributes, an empty string means no attributes.
+func parseGraphAttributes(raw string) (map[string]any, error) {
+	if raw == "" {
+		return nil, nil
+	}
+	var attributes map[string]any
+	if err := json.Unmarshal([]byte(raw), &attributes); err != nil {
+		return nil, err
+	}
+	return attributes, nil
+}
+
+// refreshGraphAttributes returns the prior JSON if the live attributes match every configured key,
+// otherwise the live values of the configured keys encoded as JSON. Keys JumpCloud adds on its own,
+// like the sudo defaults of user to system edges, are ignored, and null attributes stay null.
+func refreshGraphAttributes(prior types.String, live map[string]any) types.String {
+	if prior.IsNull() || prior.IsUnknown() {
+		return prior
+	}
+	known, err := parseGraphAttributes(prior.ValueString())
+	if err != nil {
+		return prior
+	}
+	configured := configuredGraphAttributes(known, live)
+	if len(known) == 0 && len(configured) == 0 || reflect.DeepEqual(known, configured) {
+		return prior
+	}
+	encoded, err := json.Marshal(configured)
+	if err != nil {
+		return prior
+	}
+	return types.StringValue(string(encoded))
+}
+
+// configuredGraphAttributes returns the live attributes limited to the keys set in known, recursively.
+func configuredGraphAttributes(known, live map[string]any) map[string]any {
+	configured := map[string]any{}
+	for key, knownValue := range known {
+		liveValue, found := live[key]
+		if !found {
+			continue
+		}
+		knownObject, knownIsObject := knownValue.(map[string]any)
+		liveObject, liveIsObject := liveValue.(map[string]any)
+		if knownIsObject && liveIsObject {
+			configured[key] = configuredGraphAttributes(knownObject, liveObject)
+			continue
+		}
+		configured[key] = liveValue
+	}
+	return configured
+}
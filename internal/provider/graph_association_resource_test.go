@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceGraphAssociation_UserGroupToSystemGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Types that cannot be associated are rejected before any API call
+				Config: providerConfig + `resource "jumpcloud_graph_association" "illegal" {
+											from_type = "policy"
+											from_id   = "000000000000000000000000"
+											to_type   = "user"
+											to_id     = "000000000000000000000000"
+										}`,
+				ExpectError: regexp.MustCompile(`Illegal Graph Association`),
+			},
+			{
+				// Associate a user group with a system group with sudo attributes
+				Config: providerConfig + `resource "jumpcloud_usergroup" "graph_users" {
+											name        = "graph_association_terraform_test"
+											description = "This group made via terraform test"
+										}
+										resource "jumpcloud_system_group" "graph_systems" {
+											name = "graph_association_terraform_test"
+										}
+										resource "jumpcloud_graph_association" "association" {
+											from_type  = "user_group"
+											from_id    = jumpcloud_usergroup.graph_users.id
+											to_type    = "system_group"
+											to_id      = jumpcloud_system_group.graph_systems.id
+											attributes = jsonencode({ sudo = { enabled = true, withoutPassword = false } })
+										}`,
+				// Compose multiple test checks to verify the resource
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpcloud_graph_association.association",
+						"to_type",
+						"system_group"),
+					resource.TestCheckResourceAttrSet("jumpcloud_graph_association.association",
+						"id"),
+				),
+			},
+		},
+	})
+}
+
+func TestRefreshGraphAttributes(t *testing.T) {
+	// JumpCloud returns the sudo defaults on every user to system edge
+	live := map[string]any{
+		"sudo": map[string]any{"enabled": true, "withoutPassword": false},
+		"ldap": map[string]any{"groupName": "admins"},
+	}
+	for name, test := range map[string]struct {
+		prior types.String
+		want  types.String
+	}{
+		"unmanaged":        {types.StringNull(), types.StringNull()},
+		"matching key":     {types.StringValue(`{"sudo": {"enabled": true}}`), types.StringValue(`{"sudo": {"enabled": true}}`)},
+		"matching keys":    {types.StringValue(`{"sudo":{"withoutPassword":false,"enabled":true}}`), types.StringValue(`{"sudo":{"withoutPassword":false,"enabled":true}}`)},
+		"changed key":      {types.StringValue(`{"sudo": {"enabled": false}}`), types.StringValue(`{"sudo":{"enabled":true}}`)},
+		"missing key":      {types.StringValue(`{"sudo": {"enabled": true}, "extra": 1}`), types.StringValue(`{"sudo":{"enabled":true}}`)},
+		"empty attributes": {types.StringValue(`{}`), types.StringValue(`{}`)},
+	} {
+		if got := refreshGraphAttributes(test.prior, live); !got.Equal(test.want) {
+			t.Errorf("%s: refreshGraphAttributes(%s) = %s, want %s", name, test.prior, got, test.want)
+		}
+	}
+}
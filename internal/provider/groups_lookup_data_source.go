@@ -33,6 +33,7 @@ type jcGroupsLookupDataSourceModel struct {
 	Groups []jcGroupsLookupModel `tfsdk:"groups"`
 	Name   types.String          `tfsdk:"name"`
 	Limit  types.Int64           `tfsdk:"limit"`
+	OrgID  types.String          `tfsdk:"org_id"`
 }
 
 // NewjcGroupLookupDataSource is a helper function to simplify the provider implementation.
@@ -100,6 +101,7 @@ func (d *jcGroupDataLookupSource) Schema(_ context.Context, _ datasource.SchemaR
 				Description:         "The limit of results to return",
 				MarkdownDescription: "The limit of results to return",
 			},
+			"org_id": orgIDDataSourceAttribute(),
 		},
 	}
 }
@@ -114,6 +116,7 @@ func (d *jcGroupDataLookupSource) Read(ctx context.Context, req datasource.ReadR
 	diags := req.Config.Get(ctx, &state)                             //nolint:all
 	diags = req.Config.GetAttribute(ctx, path.Root("name"), &name)   //nolint:all
 	diags = req.Config.GetAttribute(ctx, path.Root("limit"), &limit) //nolint:all
+	d.client = withOrg(d.client, state.OrgID)
 	tflog.Info(ctx, fmt.Sprintf("Request: name %s, limit %d", name, limit))
 	if limit == 0 {
 		limit = 1
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenRefreshMargin is how long before its expiry a service account token is replaced.
+const tokenRefreshMargin = time.Minute
+
+// regionTokenURLs are the OAuth 2.0 token endpoints of the service accounts of each JumpCloud region.
+var regionTokenURLs = map[string]string{
+	"us": "https://admin-oauth.id.jumpcloud.com/oauth2/token",
+	"eu": "https://admin-oauth.id.eu.jumpcloud.com/oauth2/token",
+}
+
+// defaultTokenURL returns the token endpoint of the JumpCloud region served by hostURL,
+// or the /oauth2/token path of any other host, ex. a mock server.
+func defaultTokenURL(hostURL *url.URL) string {
+	for region, regionURL := range regionURLs {
+		if u, err := url.Parse(regionURL); err == nil && strings.EqualFold(u.Host, hostURL.Host) {
+			return regionTokenURLs[region]
+		}
+	}
+	return hostURL.JoinPath("oauth2", "token").String()
+}
+
+// checkTokenURL checks that raw is an absolute http(s) URL.
+func checkTokenURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return err
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("%q must use the http or https scheme", raw)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("%q has no host", raw)
+	}
+	return nil
+}
+
+// oauthTransport authenticates requests with a bearer token of a JumpCloud service account.
+// The token is obtained with the OAuth 2.0 client credentials grant, shared by all resources
+// of the provider and replaced shortly before it expires.
+type oauthTransport struct {
+	base         http.RoundTripper
+	tokenURL     string
+	clientID     string
+	clientSecret string
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// RoundTrip sends the request with the current access token.
+func (t *oauthTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.accessToken(req.Context())
+	if err != nil {
+		return nil, err
+	}
+	req = req.Clone(req.Context())
+	req.Header.Set("Authorization", "Bearer "+token)
+	response, err := t.base.RoundTrip(req)
+	if err == nil && response.StatusCode == http.StatusUnauthorized {
+		// The token was revoked, get a new one for the next request
+		t.mu.Lock()
+		if t.token == token {
+			t.token = ""
+		}
+		t.mu.Unlock()
+	}
+	return response, err
+}
+
+// accessToken returns the cached access token, requesting a new one when it is missing or about to expire.
+func (t *oauthTransport) accessToken(ctx context.Context) (string, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.token != "" && time.Now().Add(tokenRefreshMargin).Before(t.expiry) {
+		return t.token, nil
+	}
+
+	// Prepare request
+	form := url.Values{
+		"grant_type": {"client_credentials"},
+		"scope":      {"api"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, t.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(url.QueryEscape(t.clientID), url.QueryEscape(t.clientSecret))
+
+	// Send request
+	response, err := t.base.RoundTrip(req)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	// Parse response
+	data, err := io.ReadAll(response.Body)
+	if err != nil {
+		return "", err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return "", fmt.Errorf("requesting a service account token from %s returned HTTP %d: %s",
+			t.tokenURL, response.StatusCode, apiErrorMessage(data))
+	}
+	var payload struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int64  `json:"expires_in"`
+	}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return "", err
+	}
+	if payload.AccessToken == "" {
+		return "", fmt.Errorf("%s returned no access token", t.tokenURL)
+	}
+	t.token = payload.AccessToken
+	t.expiry = time.Now().Add(time.Duration(payload.ExpiresIn) * time.Second)
+	return t.token, nil
+}
@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOAuthTransport(t *testing.T) {
+	var tokens int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/token":
+			if id, secret, ok := r.BasicAuth(); !ok || id != "id" || secret != "secret" {
+				http.Error(w, `{"error": "invalid_client"}`, http.StatusUnauthorized)
+				return
+			}
+			if r.FormValue("grant_type") != "client_credentials" {
+				http.Error(w, `{"error": "unsupported_grant_type"}`, http.StatusBadRequest)
+				return
+			}
+			tokens++
+			// The second token expires within the refresh margin, forcing a third
+			expiresIn := 3600
+			if tokens == 2 {
+				expiresIn = 30
+			}
+			_, _ = fmt.Fprintf(w, `{"access_token": "token-%d", "token_type": "bearer", "expires_in": %d}`, tokens, expiresIn)
+		default:
+			if r.Header.Get("x-api-key") != "" {
+				http.Error(w, "unexpected API key", http.StatusBadRequest)
+				return
+			}
+			if r.URL.Query().Get("revoke") != "" {
+				w.WriteHeader(http.StatusUnauthorized)
+			}
+			_, _ = w.Write([]byte(r.Header.Get("Authorization")))
+		}
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &oauthTransport{
+		base:         http.DefaultTransport,
+		tokenURL:     server.URL + "/oauth2/token",
+		clientID:     "id",
+		clientSecret: "secret",
+	}}
+	get := func(query string) string {
+		t.Helper()
+		response, err := client.Get(server.URL + "/api/systems" + query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer response.Body.Close()
+		var body [64]byte
+		n, _ := response.Body.Read(body[:])
+		return string(body[:n])
+	}
+
+	for _, test := range []struct {
+		query, want string
+	}{
+		{"", "Bearer token-1"},
+		{"", "Bearer token-1"},          // cached
+		{"?revoke=1", "Bearer token-1"}, // rejected, dropping the token
+		{"", "Bearer token-2"},          // expires within the margin
+		{"", "Bearer token-3"},          // refreshed
+		{"", "Bearer token-3"},          // cached
+	} {
+		if got := get(test.query); got != test.want {
+			t.Errorf("GET /api/systems%s: Authorization = %q, want %q", test.query, got, test.want)
+		}
+	}
+
+	bad := &oauthTransport{base: http.DefaultTransport, tokenURL: server.URL + "/oauth2/token", clientID: "id", clientSecret: "wrong"}
+	if _, err := (&http.Client{Transport: bad}).Get(server.URL + "/api/systems"); err == nil {
+		t.Error("expected an error for invalid client credentials")
+	}
+}
+
+func TestDefaultTokenURL(t *testing.T) {
+	for raw, want := range map[string]string{
+		"https://console.jumpcloud.com":     regionTokenURLs["us"],
+		"https://console.eu.jumpcloud.com/": regionTokenURLs["eu"],
+		"http://127.0.0.1:8080":             "http://127.0.0.1:8080/oauth2/token",
+	} {
+		u, err := parseAPIURL(raw)
+		if err != nil {
+			t.Fatalf("%s: %v", raw, err)
+		}
+		if got := defaultTokenURL(u); got != want {
+			t.Errorf("%s: expected %s, got %s", raw, want, got)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// orgIDHeader selects the organization of a multi-tenant (MSP) administrator.
+const orgIDHeader = "x-org-id"
+
+// withOrg returns client, or a copy of it that sends its requests to the organization orgID when it is set.
+// Terraform creates and configures a new resource or data source for every operation, so they can
+// replace their client with the one of their organization without affecting other operations.
+func withOrg(client *jumpcloud.Client, orgID types.String) *jumpcloud.Client {
+	if client == nil || orgID.ValueString() == "" {
+		return client
+	}
+	c := *client
+	c.Headers = client.Headers.Clone()
+	c.Headers.Set(orgIDHeader, orgID.ValueString())
+	return &c
+}
+
+// orgIDResourceAttribute returns the schema of the org_id attribute of a resource.
+func orgIDResourceAttribute() resourceschema.StringAttribute {
+	return resourceschema.StringAttribute{
+		Optional:            true,
+		Description:         "The ID of the organization the object belongs to, defaults to the org_id of the provider",
+		MarkdownDescription: "The ID of the organization the object belongs to, defaults to the `org_id` of the provider",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+// orgIDDataSourceAttribute returns the schema of the org_id attribute of a data source.
+func orgIDDataSourceAttribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		Optional:            true,
+		Description:         "The ID of the organization to read from, defaults to the org_id of the provider",
+		MarkdownDescription: "The ID of the organization to read from, defaults to the `org_id` of the provider",
+	}
+}
+
+// importOrgID strips the organization from an import identifier of the form org_id:id and saves it to the org_id attribute.
+// Identifiers without an organization are returned unchanged with a null organization.
+func importOrgID(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) (types.String, string) {
+	orgID, id, found := strings.Cut(req.ID, ":")
+	if !found || !isObjectID(orgID) {
+		return types.StringNull(), req.ID
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), orgID)...)
+	return types.StringValue(orgID), id
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestWithOrg(t *testing.T) {
+	client, err := jumpcloud.NewClient("key")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if c := withOrg(client, types.StringNull()); c != client {
+		t.Error("expected the provider client without an org_id")
+	}
+
+	c := withOrg(client, types.StringValue("5f1b2c3d4e5f6a7b8c9d0e1f"))
+	if got := c.Headers.Get(orgIDHeader); got != "5f1b2c3d4e5f6a7b8c9d0e1f" {
+		t.Errorf("%s = %q, want 5f1b2c3d4e5f6a7b8c9d0e1f", orgIDHeader, got)
+	}
+	if got := client.Headers.Get(orgIDHeader); got != "" {
+		t.Errorf("provider client %s = %q, want it unchanged", orgIDHeader, got)
+	}
+}
@@ -0,0 +1,174 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+)
+
+// policyTemplate is a v2 policy template, including its configuration fields when read by ID.
+type policyTemplate struct {
+	ID           string              `json:"id"`
+	Name         string              `json:"name"`
+	DisplayName  string              `json:"displayName"`
+	Description  string              `json:"description"`
+	OsMetaFamily string              `json:"osMetaFamily"`
+	ConfigFields []policyConfigField `json:"configFields,omitempty"`
+}
+
+// policyConfigField is a configuration field of a policy template.
+type policyConfigField struct {
+	ID             string `json:"id"`
+	Name           string `json:"name"`
+	Label          string `json:"label"`
+	DisplayType    string `json:"displayType"`
+	DefaultValue   any    `json:"defaultValue"`
+	DisplayOptions any    `json:"displayOptions"`
+	Required       bool   `json:"required"`
+	ReadOnly       bool   `json:"readOnly"`
+}
+
+// policyValue is the value of a template configuration field on a policy.
+type policyValue struct {
+	ConfigFieldID string `json:"configFieldID"`
+	Value         any    `json:"value"`
+}
+
+// policyTemplateRef references the template of a policy.
+type policyTemplateRef struct {
+	ID   string `json:"id"`
+	Name string `json:"name,omitempty"`
+}
+
+// policy is a v2 device policy.
+type policy struct {
+	ID       string             `json:"id,omitempty"`
+	Name     string             `json:"name"`
+	Notes    string             `json:"notes"`
+	Template *policyTemplateRef `json:"template,omitempty"`
+	Values   []policyValue      `json:"values"`
+}
+
+// getPolicy returns a policy by ID.
+func getPolicy(ctx context.Context, c *jumpcloud.Client, policyId string) (p policy, err error) {
+	_, err = jcRequest(ctx, c, http.MethodGet, "/api/v2/policies/"+policyId, nil, nil, &p)
+	return p, err
+}
+
+// createPolicy creates a new policy.
+func createPolicy(ctx context.Context, c *jumpcloud.Client, newPolicy policy) (p policy, err error) {
+	_, err = jcRequest(ctx, c, http.MethodPost, "/api/v2/policies", nil, newPolicy, &p)
+	return p, err
+}
+
+// updatePolicy replaces the name, notes and values of a policy.
+func updatePolicy(ctx context.Context, c *jumpcloud.Client, policyId string, updatedPolicy policy) (p policy, err error) {
+	_, err = jcRequest(ctx, c, http.MethodPut, "/api/v2/policies/"+policyId, nil, updatedPolicy, &p)
+	return p, err
+}
+
+// deletePolicy deletes a policy.
+func deletePolicy(ctx context.Context, c *jumpcloud.Client, policyId string) error {
+	_, err := jcRequest(ctx, c, http.MethodDelete, "/api/v2/policies/"+policyId, nil, nil, nil)
+	return err
+}
+
+// getPolicyTemplate returns a policy template and its configuration fields by ID.
+func getPolicyTemplate(ctx context.Context, c *jumpcloud.Client, templateId string) (t policyTemplate, err error) {
+	_, err = jcRequest(ctx, c, http.MethodGet, "/api/v2/policytemplates/"+templateId, nil, nil, &t)
+	return t, err
+}
+
+// listPolicyTemplates returns every policy template matching the filters, following pagination.
+// Filters use the v2 filter syntax, ex. osMetaFamily:$eq:windows.
+func listPolicyTemplates(ctx context.Context, c *jumpcloud.Client, filters []string) ([]policyTemplate, error) {
+	var templates []policyTemplate
+	params := url.Values{
+		"limit": {strconv.Itoa(graphPageSize)},
+	}
+	if len(filters) > 0 {
+		params["filter"] = filters
+	}
+	for skip := 0; ; skip += graphPageSize {
+		var page []policyTemplate
+		params.Set("skip", strconv.Itoa(skip))
+		if _, err := jcRequest(ctx, c, http.MethodGet, "/api/v2/policytemplates", params, nil, &page); err != nil {
+			return nil, err
+		}
+		templates = append(templates, page...)
+		if len(page) < graphPageSize {
+			return templates, nil
+		}
+	}
+}
+
+// findPolicyTemplate returns the policy template with the given name, including its configuration fields.
+func findPolicyTemplate(ctx context.Context, c *jumpcloud.Client, name string) (policyTemplate, error) {
+	templates, err := listPolicyTemplates(ctx, c, []string{"name:$eq:" + name})
+	if err != nil {
+		return policyTemplate{}, err
+	}
+	if len(templates) != 1 {
+		return policyTemplate{}, fmt.Errorf("expected one policy template named %q, found %d", name, len(templates))
+	}
+	return getPolicyTemplate(ctx, c, templates[0].ID)
+}
+
+// policyFieldValue converts the string form of a configuration field value to the type the API expects.
+// Checkboxes are booleans, number fields are numbers, list and table fields are JSON, everything else is a string.
+func policyFieldValue(field policyConfigField, s string) (any, error) {
+	switch field.DisplayType {
+	case "checkbox":
+		return strconv.ParseBool(s)
+	case "number":
+		return strconv.ParseFloat(s, 64)
+	case "table", "listbox", "multilist":
+		var v any
+		if err := json.Unmarshal([]byte(s), &v); err != nil {
+			return nil, fmt.Errorf("%s must be JSON: %w", field.Name, err)
+		}
+		return v, nil
+	default:
+		return s, nil
+	}
+}
+
+// policyFieldString converts a configuration field value returned by the API to its string form.
+func policyFieldString(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		b, _ := json.Marshal(v)
+		return string(b)
+	}
+}
+
+// policyFieldAllowedValues returns the values offered by a select style field,
+// ex. displayOptions {"select": [{"text": "Never", "value": "0"}]}.
+func policyFieldAllowedValues(field policyConfigField) []string {
+	var values []string
+	options, _ := field.DisplayOptions.(map[string]any)
+	for _, key := range sortedKeys(options) {
+		choices, _ := options[key].([]any)
+		for _, choice := range choices {
+			if c, ok := choice.(map[string]any); ok {
+				if v, ok := c["value"]; ok {
+					values = append(values, policyFieldString(v))
+				}
+			}
+		}
+	}
+	return values
+}
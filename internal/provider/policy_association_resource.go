@@ -0,0 +1,260 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &jcPolicyAssociationResource{}
+	_ resource.ResourceWithConfigure        = &jcPolicyAssociationResource{}
+	_ resource.ResourceWithImportState      = &jcPolicyAssociationResource{}
+	_ resource.ResourceWithConfigValidators = &jcPolicyAssociationResource{}
+)
+
+// policyAssociationTargetTypes are the object types a policy or policy group can be bound to.
+var policyAssociationTargetTypes = []string{"system", "system_group"}
+
+// NewPolicyAssociationResource is a helper function to simplify the provider implementation.
+func NewPolicyAssociationResource() resource.Resource {
+	return &jcPolicyAssociationResource{}
+}
+
+// jcPolicyAssociationResource is the resource implementation.
+// Unlike the system_ids and system_group_ids attributes of jcPolicyResource and jcPolicyGroupResource
+// it manages a single binding and never touches the other bindings of the policy.
+type jcPolicyAssociationResource struct {
+	client *jumpcloud.Client
+}
+
+// PolicyAssociationResourceModel is the local model for this resource type.
+type PolicyAssociationResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	PolicyID      types.String `tfsdk:"policy_id"`
+	PolicyGroupID types.String `tfsdk:"policy_group_id"`
+	TargetType    types.String `tfsdk:"target_type"`
+	TargetID      types.String `tfsdk:"target_id"`
+	OrgID         types.String `tfsdk:"org_id"`
+}
+
+// source returns the graph object type and ID of the bound policy or policy group.
+func (m PolicyAssociationResourceModel) source() (string, string) {
+	if !m.PolicyGroupID.IsNull() {
+		return "policy_group", m.PolicyGroupID.ValueString()
+	}
+	return "policy", m.PolicyID.ValueString()
+}
+
+// Metadata returns the resource type name.
+func (r *jcPolicyAssociationResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_association"
+}
+
+// Schema defines the schema for the resource.
+func (r *jcPolicyAssociationResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The association ID in the form source_type/source_id/target_type/target_id, where source_type is policy or policy_group",
+				MarkdownDescription: "The association ID in the form `source_type/source_id/target_type/target_id`, where `source_type` is `policy` or `policy_group`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"policy_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Policy ID. Conflicts with policy_group_id",
+				MarkdownDescription: "Policy ID. Conflicts with `policy_group_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"policy_group_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Policy Group ID. Conflicts with policy_id",
+				MarkdownDescription: "Policy Group ID. Conflicts with `policy_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_type": schema.StringAttribute{
+				Required:            true,
+				Description:         "Can be system or system_group",
+				MarkdownDescription: "Can be `system` or `system_group`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(policyAssociationTargetTypes...),
+				},
+			},
+			"target_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the system or system group to apply the policy to",
+				MarkdownDescription: "The ID of the system or system group to apply the policy to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"org_id": orgIDResourceAttribute(),
+		},
+	}
+}
+
+// ConfigValidators requires exactly one of policy_id and policy_group_id.
+func (r *jcPolicyAssociationResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("policy_id"),
+			path.MatchRoot("policy_group_id"),
+		),
+	}
+}
+
+// Create binds the policy to the target and sets the initial Terraform state.
+func (r *jcPolicyAssociationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan PolicyAssociationResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	// Bind the policy to the target
+	sourceType, sourceId := plan.source()
+	tflog.Info(ctx, fmt.Sprintf("BINDING %s %s TO %s %s", sourceType, sourceId, plan.TargetType.ValueString(), plan.TargetID.ValueString()))
+	err := modifyGraphConnection(ctx, r.client, graphAssociationsPath(sourceType, sourceId), "add", plan.TargetType.ValueString(), plan.TargetID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Binding Policy",
+			"Could not bind "+sourceType+" "+sourceId+" to "+plan.TargetType.ValueString()+" "+plan.TargetID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	plan.ID = types.StringValue(sourceType + "/" + sourceId + "/" + plan.TargetType.ValueString() + "/" + plan.TargetID.ValueString())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jcPolicyAssociationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state PolicyAssociationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Check that the binding still exists
+	sourceType, sourceId := state.source()
+	association, err := getGraphAssociation(ctx, r.client, sourceType, sourceId, state.TargetType.ValueString(), state.TargetID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Reading Policy Bindings",
+			"Could not read the bindings of "+sourceType+" "+sourceId+": "+err.Error(),
+		)
+		return
+	}
+	if association == nil {
+		// The binding or the policy was removed outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(sourceType + "/" + sourceId + "/" + state.TargetType.ValueString() + "/" + state.TargetID.ValueString())
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called because every attribute requires replacement.
+func (r *jcPolicyAssociationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan PolicyAssociationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the binding and removes the Terraform state on success.
+func (r *jcPolicyAssociationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state PolicyAssociationResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Remove only this binding
+	sourceType, sourceId := state.source()
+	tflog.Info(ctx, fmt.Sprintf("UNBINDING %s %s FROM %s %s", sourceType, sourceId, state.TargetType.ValueString(), state.TargetID.ValueString()))
+	err := modifyGraphConnection(ctx, r.client, graphAssociationsPath(sourceType, sourceId), "remove", state.TargetType.ValueString(), state.TargetID.ValueString(), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Removing Policy Binding",
+			"Could not unbind "+sourceType+" "+sourceId+" from "+state.TargetType.ValueString()+" "+state.TargetID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jcPolicyAssociationResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of resource
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource state from a source_type/source_id/target_type/target_id composite ID, optionally prefixed with org_id:.
+func (r *jcPolicyAssociationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	_, id := importOrgID(ctx, req, resp)
+	parts := strings.Split(id, "/")
+	if len(parts) != 4 || parts[1] == "" || parts[3] == "" ||
+		(parts[0] != "policy" && parts[0] != "policy_group") || !slices.Contains(policyAssociationTargetTypes, parts[2]) {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: [org_id:]source_type/source_id/target_type/target_id where source_type is policy or policy_group and target_type is one of %s. Got: %q",
+				strings.Join(policyAssociationTargetTypes, ", "), req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(parts[0]+"_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_type"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target_id"), parts[3])...)
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourcePolicyAssociation_BindSystemGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Bind a new policy to a new system group
+				Config: providerConfig + `resource "jumpcloud_policy" "policy" {
+											name          = "policy_association_terraform_test"
+											template_name = "screen_lock_darwin"
+										}
+										resource "jumpcloud_system_group" "systems" {
+											name = "policy_association_terraform_test"
+										}
+										resource "jumpcloud_policy_association" "association" {
+											policy_id   = jumpcloud_policy.policy.id
+											target_type = "system_group"
+											target_id   = jumpcloud_system_group.systems.id
+										}`,
+				// Compose multiple test checks to verify the resource
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("jumpcloud_policy_association.association",
+						"target_id",
+						"jumpcloud_system_group.systems",
+						"id"),
+				),
+			},
+			{
+				// Import the association by policy/policy_id/target_type/target_id
+				ResourceName:      "jumpcloud_policy_association.association",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
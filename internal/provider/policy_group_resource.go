@@ -0,0 +1,330 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &jcPolicyGroupResource{}
+	_ resource.ResourceWithConfigure   = &jcPolicyGroupResource{}
+	_ resource.ResourceWithImportState = &jcPolicyGroupResource{}
+)
+
+// NewPolicyGroupResource is a helper function to simplify the provider implementation.
+func NewPolicyGroupResource() resource.Resource {
+	return &jcPolicyGroupResource{}
+}
+
+// jcPolicyGroupResource is the resource implementation.
+type jcPolicyGroupResource struct {
+	client *jumpcloud.Client
+}
+
+// PolicyGroupResourceModel is the local model for this resource type.
+type PolicyGroupResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Policies       types.Set    `tfsdk:"policies"`
+	SystemIDs      types.Set    `tfsdk:"system_ids"`
+	SystemGroupIDs types.Set    `tfsdk:"system_group_ids"`
+	OrgID          types.String `tfsdk:"org_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *jcPolicyGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *jcPolicyGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Policy Group ID",
+				MarkdownDescription: "Policy Group ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Policy Group Name",
+				MarkdownDescription: "Policy Group Name",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "Policy Group Description",
+				MarkdownDescription: "Policy Group Description",
+			},
+			// Set attribute does not care about order
+			"policies": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Description:         "IDs of the policies in the group. Leave unset to leave membership unmanaged",
+				MarkdownDescription: "IDs of the policies in the group. Leave unset to leave membership unmanaged",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"system_ids":       systemTargetSchema("system", "the policy group applies to", "jumpcloud_policy_association"),
+			"system_group_ids": systemTargetSchema("system_group", "the policy group applies to", "jumpcloud_policy_association"),
+			"org_id":           orgIDResourceAttribute(),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *jcPolicyGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan PolicyGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	// Create new group, check for errors
+	g, err := createPolicyGroup(ctx, r.client, policyGroup{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating policy group",
+			"Could not create policy group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Created Jumpcloud Policy Group: %s", g.Name))
+
+	// Save the ID right away so a failure below does not orphan the group
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), g.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), plan.OrgID)...)
+
+	// Add members and bindings
+	resp.Diagnostics.Append(r.setMembers(ctx, req.Config, g.ID, plan.Policies)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(setSystemTargets(ctx, r.client, req.Config, "policy_group", g.ID, plan.SystemIDs, plan.SystemGroupIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state, diags := r.readState(ctx, g.ID)
+	resp.Diagnostics.Append(diags...)
+	state.OrgID = plan.OrgID
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jcPolicyGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state PolicyGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Get refreshed group value from JumpCloud
+	tflog.Info(ctx, fmt.Sprintf("Looking Up Policy Group ID: %s", state.ID.ValueString()))
+	if _, err := getPolicyGroup(ctx, r.client, state.ID.ValueString()); isNotFound(err) {
+		// The group was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	newState, diags := r.readState(ctx, state.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	newState.OrgID = state.OrgID
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *jcPolicyGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state PolicyGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	groupId := state.ID.ValueString()
+
+	// Update group, reference the state's group Id
+	_, err := updatePolicyGroup(ctx, r.client, groupId, policyGroup{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Modifying Policy Group",
+			"Could not modify Policy Group ID "+groupId+": "+err.Error(),
+		)
+		return
+	}
+
+	// Reconcile members and bindings that are set in the configuration
+	resp.Diagnostics.Append(r.setMembers(ctx, req.Config, groupId, plan.Policies)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(setSystemTargets(ctx, r.client, req.Config, "policy_group", groupId, plan.SystemIDs, plan.SystemGroupIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get the updated group
+	newState, diags := r.readState(ctx, groupId)
+	resp.Diagnostics.Append(diags...)
+	newState.OrgID = plan.OrgID
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *jcPolicyGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state PolicyGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Delete existing group. This object will be purged from the state file so there is no need to return values
+	err := deletePolicyGroup(ctx, r.client, state.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Policy Group",
+			"Could not delete policy group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jcPolicyGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of resource
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource state from live resources via their ID attribute, optionally prefixed with org_id:.
+func (r *jcPolicyGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	_, id := importOrgID(ctx, req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// setMembers makes the planned policies the only members of the group.
+// Membership is only reconciled when policies are set in the configuration.
+func (r *jcPolicyGroupResource) setMembers(ctx context.Context, config tfsdk.Config, groupId string, policies types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var configPolicies types.Set
+	diags.Append(config.GetAttribute(ctx, path.Root("policies"), &configPolicies)...)
+	if diags.HasError() || configPolicies.IsNull() {
+		return diags
+	}
+	var wantIds []string
+	diags.Append(policies.ElementsAs(ctx, &wantIds, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Add the planned policies that are not members and remove the members that are not planned
+	if err := setGraphConnections(ctx, r.client, graphMembersPath("policy_group", groupId), nil, "policy", graphEdges(wantIds), nil); err != nil {
+		diags.AddError(
+			"Error Updating Policy Group Members",
+			"Could not update the members of Policy Group ID "+groupId+": "+err.Error(),
+		)
+	}
+	return diags
+}
+
+// readState reads the group, its members and its bindings from JumpCloud.
+func (r *jcPolicyGroupResource) readState(ctx context.Context, groupId string) (state PolicyGroupResourceModel, diags diag.Diagnostics) {
+	group, err := getPolicyGroup(ctx, r.client, groupId)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Jumpcloud Policy Group",
+			"Could not read Jumpcloud Policy Group ID "+groupId+": "+err.Error(),
+		)
+		return state, diags
+	}
+	memberIds, err := getPolicyGroupMemberIDs(ctx, r.client, groupId)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Policy Group Members",
+			"Could not read members of Policy Group ID "+groupId+": "+err.Error(),
+		)
+		return state, diags
+	}
+
+	state = PolicyGroupResourceModel{
+		ID:          types.StringValue(group.ID),
+		Name:        types.StringValue(group.Name),
+		Description: types.StringValue(group.Description),
+	}
+	policies, d := types.SetValueFrom(ctx, types.StringType, memberIds)
+	diags.Append(d...)
+	state.Policies = policies
+	state.SystemIDs, state.SystemGroupIDs, d = readSystemTargets(ctx, r.client, "policy_group", groupId)
+	diags.Append(d...)
+	return state, diags
+}
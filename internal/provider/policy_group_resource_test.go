@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourcePolicyGroup_CreateGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create a policy group with one policy bound to a system group
+				Config: providerConfig + `resource "jumpcloud_policy" "policy" {
+											name          = "policy_group_terraform_test"
+											template_name = "screen_lock_darwin"
+										}
+										resource "jumpcloud_system_group" "systems" {
+											name = "policy_group_terraform_test"
+										}
+										resource "jumpcloud_policy_group" "group" {
+											name             = "policy_group_terraform_test"
+											description      = "This group made via terraform test"
+											policies         = [jumpcloud_policy.policy.id]
+											system_group_ids = [jumpcloud_system_group.systems.id]
+										}`,
+				// Compose multiple test checks to verify the resource
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpcloud_policy_group.group", "policies.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("jumpcloud_policy_group.group", "policies.*", "jumpcloud_policy.policy", "id"),
+					resource.TestCheckTypeSetElemAttrPair("jumpcloud_policy_group.group", "system_group_ids.*", "jumpcloud_system_group.systems", "id"),
+				),
+			},
+			{
+				// Remove the policy and the binding
+				Config: providerConfig + `resource "jumpcloud_policy" "policy" {
+											name          = "policy_group_terraform_test"
+											template_name = "screen_lock_darwin"
+										}
+										resource "jumpcloud_system_group" "systems" {
+											name = "policy_group_terraform_test"
+										}
+										resource "jumpcloud_policy_group" "group" {
+											name             = "policy_group_terraform_test"
+											policies         = []
+											system_group_ids = []
+										}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpcloud_policy_group.group", "policies.#", "0"),
+					resource.TestCheckResourceAttr("jumpcloud_policy_group.group", "system_group_ids.#", "0"),
+				),
+			},
+			{
+				ResourceName:      "jumpcloud_policy_group.group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
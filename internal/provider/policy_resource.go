@@ -0,0 +1,420 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &jcPolicyResource{}
+	_ resource.ResourceWithConfigure   = &jcPolicyResource{}
+	_ resource.ResourceWithImportState = &jcPolicyResource{}
+)
+
+// NewPolicyResource is a helper function to simplify the provider implementation.
+func NewPolicyResource() resource.Resource {
+	return &jcPolicyResource{}
+}
+
+// jcPolicyResource is the resource implementation.
+type jcPolicyResource struct {
+	client *jumpcloud.Client
+}
+
+// PolicyResourceModel is the local model for this resource type.
+type PolicyResourceModel struct {
+	ID             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	TemplateName   types.String `tfsdk:"template_name"`
+	TemplateID     types.String `tfsdk:"template_id"`
+	Notes          types.String `tfsdk:"notes"`
+	Values         types.Map    `tfsdk:"values"`
+	SystemIDs      types.Set    `tfsdk:"system_ids"`
+	SystemGroupIDs types.Set    `tfsdk:"system_group_ids"`
+	OrgID          types.String `tfsdk:"org_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *jcPolicyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy"
+}
+
+// Schema defines the schema for the resource.
+func (r *jcPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Policy ID",
+				MarkdownDescription: "Policy ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the policy",
+				MarkdownDescription: "The name of the policy",
+			},
+			"template_name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the policy template, ex. screen_lock_darwin. See the jumpcloud_policy_templates data source",
+				MarkdownDescription: "The name of the policy template, ex. `screen_lock_darwin`. See the `jumpcloud_policy_templates` data source",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"template_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the policy template",
+				MarkdownDescription: "The ID of the policy template",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "Notes about the policy",
+				MarkdownDescription: "Notes about the policy",
+			},
+			"values": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+				Description:         "Template configuration field values keyed by field name. Checkbox fields take true or false, number fields a number and list or table fields JSON. Fields not set keep their current or default value",
+				MarkdownDescription: "Template configuration field values keyed by field name. Checkbox fields take `true` or `false`, number fields a number and list or table fields JSON. Fields not set keep their current or default value",
+			},
+			"system_ids":       systemTargetSchema("system", "the policy applies to", "jumpcloud_policy_association"),
+			"system_group_ids": systemTargetSchema("system_group", "the policy applies to", "jumpcloud_policy_association"),
+			"org_id":           orgIDResourceAttribute(),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *jcPolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan PolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	// Look up the template and its configuration fields
+	template, err := findPolicyTemplate(ctx, r.client, plan.TemplateName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("template_name"),
+			"Error reading policy template",
+			"Could not read policy template "+plan.TemplateName.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	values, diags := policyValues(ctx, template, plan.Values, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new policy, check for errors
+	p, err := createPolicy(ctx, r.client, policy{
+		Name:     plan.Name.ValueString(),
+		Notes:    plan.Notes.ValueString(),
+		Template: &policyTemplateRef{ID: template.ID},
+		Values:   values,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating policy",
+			"Could not create policy, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Created policy %s from template %s", p.ID, template.Name))
+
+	plan.ID = types.StringValue(p.ID)
+	plan.TemplateID = types.StringValue(template.ID)
+
+	// Save the ID right away so a failure below does not orphan the policy
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), p.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), plan.OrgID)...)
+
+	// Bind the policy to systems and system groups
+	resp.Diagnostics.Append(setSystemTargets(ctx, r.client, req.Config, "policy", p.ID, plan.SystemIDs, plan.SystemGroupIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.SystemIDs, plan.SystemGroupIDs, diags = readSystemTargets(ctx, r.client, "policy", p.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jcPolicyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state PolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Get refreshed policy value from JumpCloud
+	tflog.Info(ctx, fmt.Sprintf("Looking Up Policy ID: %s", state.ID.ValueString()))
+	p, err := getPolicy(ctx, r.client, state.ID.ValueString())
+	if isNotFound(err) {
+		// The policy was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Jumpcloud Policy",
+			"Could not read Jumpcloud Policy ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if p.Template == nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Jumpcloud Policy",
+			"Jumpcloud Policy ID "+state.ID.ValueString()+" has no template",
+		)
+		return
+	}
+	template, err := getPolicyTemplate(ctx, r.client, p.Template.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading policy template",
+			"Could not read policy template "+p.Template.ID+": "+err.Error(),
+		)
+		return
+	}
+
+	// Only the values managed by this resource are tracked, every value after an import
+	tracked := map[string]bool{}
+	all := state.Values.IsNull()
+	for name := range state.Values.Elements() {
+		tracked[name] = true
+	}
+	fieldNames := map[string]string{}
+	for _, field := range template.ConfigFields {
+		fieldNames[field.ID] = field.Name
+	}
+	values := map[string]attr.Value{}
+	for _, value := range p.Values {
+		name := fieldNames[value.ConfigFieldID]
+		if name != "" && (all || tracked[name]) {
+			values[name] = types.StringValue(policyFieldString(value.Value))
+		}
+	}
+
+	// Overwrite items with refreshed state
+	state.Name = types.StringValue(p.Name)
+	state.Notes = types.StringValue(p.Notes)
+	state.TemplateName = types.StringValue(template.Name)
+	state.TemplateID = types.StringValue(template.ID)
+	state.Values, diags = types.MapValue(types.StringType, values)
+	resp.Diagnostics.Append(diags...)
+	state.SystemIDs, state.SystemGroupIDs, diags = readSystemTargets(ctx, r.client, "policy", p.ID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *jcPolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state PolicyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	// Keep the values that are not managed by this resource
+	current, err := getPolicy(ctx, r.client, state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Jumpcloud Policy",
+			"Could not read Jumpcloud Policy ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	template, err := getPolicyTemplate(ctx, r.client, state.TemplateID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading policy template",
+			"Could not read policy template "+state.TemplateID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	values, diags := policyValues(ctx, template, plan.Values, current.Values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update policy, reference the state's policy Id
+	_, err = updatePolicy(ctx, r.client, state.ID.ValueString(), policy{
+		Name:     plan.Name.ValueString(),
+		Notes:    plan.Notes.ValueString(),
+		Template: &policyTemplateRef{ID: template.ID},
+		Values:   values,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating policy",
+			"Could not update policy, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = state.ID
+	plan.TemplateID = state.TemplateID
+
+	// Bind the policy to systems and system groups
+	resp.Diagnostics.Append(setSystemTargets(ctx, r.client, req.Config, "policy", state.ID.ValueString(), plan.SystemIDs, plan.SystemGroupIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.SystemIDs, plan.SystemGroupIDs, diags = readSystemTargets(ctx, r.client, "policy", state.ID.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *jcPolicyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state PolicyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Delete existing policy
+	err := deletePolicy(ctx, r.client, state.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Jumpcloud Policy",
+			"Could not delete policy, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jcPolicyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of resource
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource state from an existing resource, optionally prefixed with org_id:.
+func (r *jcPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	_, id := importOrgID(ctx, req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// policyValues converts the values attribute to API values, typed according to the template fields.
+// current values of fields that are not configured are kept.
+func policyValues(ctx context.Context, template policyTemplate, configured types.Map, current []policyValue) ([]policyValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var byName map[string]string
+	diags.Append(configured.ElementsAs(ctx, &byName, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	fields := map[string]policyConfigField{}
+	for _, field := range template.ConfigFields {
+		fields[field.Name] = field
+	}
+
+	values := map[string]any{}
+	for _, value := range current {
+		values[value.ConfigFieldID] = value.Value
+	}
+	for _, name := range sortedKeys(byName) {
+		field, ok := fields[name]
+		if !ok {
+			diags.AddAttributeError(
+				path.Root("values").AtMapKey(name),
+				"Unknown policy field",
+				fmt.Sprintf("Template %s has no field %q. Valid fields are: %s", template.Name, name, strings.Join(sortedKeys(fields), ", ")),
+			)
+			continue
+		}
+		value, err := policyFieldValue(field, byName[name])
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("values").AtMapKey(name),
+				"Invalid policy field value",
+				fmt.Sprintf("Could not convert %q to a %s value: %s", byName[name], field.DisplayType, err.Error()),
+			)
+			continue
+		}
+		values[field.ID] = value
+	}
+
+	var result []policyValue
+	for _, id := range sortedKeys(values) {
+		result = append(result, policyValue{ConfigFieldID: id, Value: values[id]})
+	}
+	return result, diags
+}
@@ -0,0 +1,47 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourcePolicy_CreatePolicy(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create a policy from a template
+				Config: providerConfig + `resource "jumpcloud_policy" "policy" {
+											name          = "terraform_test_policy"
+											template_name = "screen_lock_darwin"
+											notes         = "This policy made via terraform test"
+										}`,
+				// Compose multiple test checks to verify the resource
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("jumpcloud_policy.policy", "id"),
+					resource.TestCheckResourceAttrSet("jumpcloud_policy.policy", "template_id"),
+					resource.TestCheckResourceAttr("jumpcloud_policy.policy", "notes", "This policy made via terraform test"),
+				),
+			},
+			{
+				// Rename the policy in place
+				Config: providerConfig + `resource "jumpcloud_policy" "policy" {
+											name          = "terraform_test_policy_renamed"
+											template_name = "screen_lock_darwin"
+										}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpcloud_policy.policy", "name", "terraform_test_policy_renamed"),
+					resource.TestCheckResourceAttr("jumpcloud_policy.policy", "notes", ""),
+				),
+			},
+			{
+				// Imports read every template value, so values are not compared
+				ResourceName:            "jumpcloud_policy.policy",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"values"},
+			},
+		},
+	})
+}
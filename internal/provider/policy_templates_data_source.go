@@ -0,0 +1,283 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &jcPolicyTemplatesDataSource{}
+	_ datasource.DataSourceWithConfigure        = &jcPolicyTemplatesDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &jcPolicyTemplatesDataSource{}
+)
+
+// jcPolicyTemplateModel maps a policy template to a Go type.
+type jcPolicyTemplateModel struct {
+	ID          types.String                 `tfsdk:"id"`
+	Name        types.String                 `tfsdk:"name"`
+	DisplayName types.String                 `tfsdk:"display_name"`
+	Description types.String                 `tfsdk:"description"`
+	OsFamily    types.String                 `tfsdk:"os_family"`
+	Fields      []jcPolicyTemplateFieldModel `tfsdk:"fields"`
+}
+
+// jcPolicyTemplateFieldModel maps a policy template configuration field to a Go type.
+type jcPolicyTemplateFieldModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Name          types.String   `tfsdk:"name"`
+	Label         types.String   `tfsdk:"label"`
+	Type          types.String   `tfsdk:"type"`
+	Required      types.Bool     `tfsdk:"required"`
+	ReadOnly      types.Bool     `tfsdk:"read_only"`
+	DefaultValue  types.String   `tfsdk:"default_value"`
+	AllowedValues []types.String `tfsdk:"allowed_values"`
+}
+
+// jcPolicyTemplatesDataSourceModel maps the data source schema data.
+type jcPolicyTemplatesDataSourceModel struct {
+	OsFamily  types.String            `tfsdk:"os_family"`
+	Name      types.String            `tfsdk:"name"`
+	Templates []jcPolicyTemplateModel `tfsdk:"templates"`
+	OrgID     types.String            `tfsdk:"org_id"`
+}
+
+// NewjcPolicyTemplatesDataSource is a helper function to simplify the provider implementation.
+func NewjcPolicyTemplatesDataSource() datasource.DataSource {
+	return &jcPolicyTemplatesDataSource{}
+}
+
+// jcPolicyTemplatesDataSource is the data source implementation.
+// This struct accepts a client pointer to the JumpCloud Go client so terraform can make its changes to the system.
+type jcPolicyTemplatesDataSource struct {
+	client *jumpcloud.Client
+}
+
+// Metadata returns the data source type name.
+func (d *jcPolicyTemplatesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_templates"
+}
+
+// Schema defines the schema for the data source.
+func (d *jcPolicyTemplatesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the device policy templates matching os_family and name. At least one filter is required, " +
+			"as the configuration fields are read with one request per matching template.",
+		MarkdownDescription: "Lists the device policy templates matching `os_family` and `name`. At least one filter is required, " +
+			"as the configuration fields are read with one request per matching template. Prefer a `name` filter to keep plans fast.",
+		Attributes: map[string]schema.Attribute{
+			"os_family": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return templates for this OS family, can be windows, darwin, linux, ios or android",
+				MarkdownDescription: "Only return templates for this OS family, can be `windows`, `darwin`, `linux`, `ios` or `android`",
+				Validators: []validator.String{
+					stringvalidator.OneOf("windows", "darwin", "linux", "ios", "android"),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return templates whose name or display name contains this text, case insensitive",
+				MarkdownDescription: "Only return templates whose name or display name contains this text, case insensitive",
+			},
+			"templates": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "A list of Jumpcloud Policy Templates",
+				MarkdownDescription: "A list of Jumpcloud Policy Templates",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The ID of the template",
+							MarkdownDescription: "The ID of the template",
+						},
+						"name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the template, used as template_name of jumpcloud_policy",
+							MarkdownDescription: "The name of the template, used as `template_name` of `jumpcloud_policy`",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The display name of the template",
+							MarkdownDescription: "The display name of the template",
+						},
+						"description": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The description of the template",
+							MarkdownDescription: "The description of the template",
+						},
+						"os_family": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The OS family the template applies to",
+							MarkdownDescription: "The OS family the template applies to",
+						},
+						"fields": schema.ListNestedAttribute{
+							Computed:            true,
+							Description:         "The configuration fields of the template, set through the values of jumpcloud_policy",
+							MarkdownDescription: "The configuration fields of the template, set through the `values` of `jumpcloud_policy`",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"id": schema.StringAttribute{
+										Computed:            true,
+										Description:         "The ID of the field",
+										MarkdownDescription: "The ID of the field",
+									},
+									"name": schema.StringAttribute{
+										Computed:            true,
+										Description:         "The name of the field, used as key in the values of jumpcloud_policy",
+										MarkdownDescription: "The name of the field, used as key in the `values` of `jumpcloud_policy`",
+									},
+									"label": schema.StringAttribute{
+										Computed:            true,
+										Description:         "The label of the field in the console",
+										MarkdownDescription: "The label of the field in the console",
+									},
+									"type": schema.StringAttribute{
+										Computed:            true,
+										Description:         "The display type of the field, ex. checkbox, number, select or text",
+										MarkdownDescription: "The display type of the field, ex. `checkbox`, `number`, `select` or `text`",
+									},
+									"required": schema.BoolAttribute{
+										Computed:            true,
+										Description:         "Whether the field must be set",
+										MarkdownDescription: "Whether the field must be set",
+									},
+									"read_only": schema.BoolAttribute{
+										Computed:            true,
+										Description:         "Whether the field cannot be changed",
+										MarkdownDescription: "Whether the field cannot be changed",
+									},
+									"default_value": schema.StringAttribute{
+										Computed:            true,
+										Description:         "The default value of the field, in the same form as the values of jumpcloud_policy",
+										MarkdownDescription: "The default value of the field, in the same form as the `values` of `jumpcloud_policy`",
+									},
+									"allowed_values": schema.ListAttribute{
+										Computed:            true,
+										ElementType:         types.StringType,
+										Description:         "The values a select field accepts, empty for free form fields",
+										MarkdownDescription: "The values a select field accepts, empty for free form fields",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"org_id": orgIDDataSourceAttribute(),
+		},
+	}
+}
+
+// ConfigValidators requires a filter, so a plan never reads every template of the catalog.
+func (d *jcPolicyTemplatesDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.AtLeastOneOf(
+			path.MatchRoot("os_family"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *jcPolicyTemplatesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state jcPolicyTemplatesDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = withOrg(d.client, state.OrgID)
+
+	// Get all templates of the OS family
+	var filters []string
+	if !state.OsFamily.IsNull() {
+		filters = append(filters, "osMetaFamily:$eq:"+state.OsFamily.ValueString())
+	}
+	templates, err := listPolicyTemplates(ctx, d.client, filters)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Jumpcloud Policy Templates",
+			err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Read Jumpcloud Policy Templates: %v", len(templates)))
+
+	// Map response to state
+	search := strings.ToLower(state.Name.ValueString())
+	state.Templates = []jcPolicyTemplateModel{}
+	for _, t := range templates {
+		if !strings.Contains(strings.ToLower(t.Name), search) && !strings.Contains(strings.ToLower(t.DisplayName), search) {
+			continue
+		}
+
+		// The configuration fields are only returned per template, so only matching templates are read
+		template, err := getPolicyTemplate(ctx, d.client, t.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Jumpcloud Policy Template",
+				"Could not read policy template "+t.Name+": "+err.Error(),
+			)
+			return
+		}
+		templateState := jcPolicyTemplateModel{
+			ID:          types.StringValue(template.ID),
+			Name:        types.StringValue(template.Name),
+			DisplayName: types.StringValue(template.DisplayName),
+			Description: types.StringValue(template.Description),
+			OsFamily:    types.StringValue(template.OsMetaFamily),
+			Fields:      []jcPolicyTemplateFieldModel{},
+		}
+		for _, field := range template.ConfigFields {
+			allowedValues := []types.String{}
+			for _, value := range policyFieldAllowedValues(field) {
+				allowedValues = append(allowedValues, types.StringValue(value))
+			}
+			templateState.Fields = append(templateState.Fields, jcPolicyTemplateFieldModel{
+				ID:            types.StringValue(field.ID),
+				Name:          types.StringValue(field.Name),
+				Label:         types.StringValue(field.Label),
+				Type:          types.StringValue(field.DisplayType),
+				Required:      types.BoolValue(field.Required),
+				ReadOnly:      types.BoolValue(field.ReadOnly),
+				DefaultValue:  types.StringValue(policyFieldString(field.DefaultValue)),
+				AllowedValues: allowedValues,
+			})
+		}
+		state.Templates = append(state.Templates, templateState)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *jcPolicyTemplatesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of data source
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
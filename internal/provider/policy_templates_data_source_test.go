@@ -0,0 +1,29 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourcePolicyTemplates_FilterByOsFamily(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Ensure that data source jumpcloud_policy_templates returns at least one darwin template
+				Config: providerConfig + `data "jumpcloud_policy_templates" "test" {
+											os_family = "darwin"
+										}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(
+						"data.jumpcloud_policy_templates.test",
+						"templates.#",
+						regexp.MustCompile(`^0*[1-9]\d*$`)), // regex for a positive integer
+					resource.TestCheckResourceAttr("data.jumpcloud_policy_templates.test", "templates.0.os_family", "darwin"),
+				),
+			},
+		},
+	})
+}
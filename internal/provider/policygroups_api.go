@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+)
+
+// policyGroup is the structure of a v2 Policy Group object.
+type policyGroup struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Type        string `json:"type,omitempty"`
+}
+
+// getPolicyGroup returns a policy group by ID.
+func getPolicyGroup(ctx context.Context, c *jumpcloud.Client, groupId string) (group policyGroup, err error) {
+	_, err = jcRequest(ctx, c, http.MethodGet, "/api/v2/policygroups/"+groupId, nil, nil, &group)
+	return group, err
+}
+
+// createPolicyGroup creates a new policy group.
+func createPolicyGroup(ctx context.Context, c *jumpcloud.Client, newGroup policyGroup) (group policyGroup, err error) {
+	_, err = jcRequest(ctx, c, http.MethodPost, "/api/v2/policygroups", nil, newGroup, &group)
+	return group, err
+}
+
+// updatePolicyGroup updates the name and description of a policy group.
+func updatePolicyGroup(ctx context.Context, c *jumpcloud.Client, groupId string, updatedGroup policyGroup) (group policyGroup, err error) {
+	_, err = jcRequest(ctx, c, http.MethodPut, "/api/v2/policygroups/"+groupId, nil, updatedGroup, &group)
+	return group, err
+}
+
+// deletePolicyGroup deletes a policy group.
+func deletePolicyGroup(ctx context.Context, c *jumpcloud.Client, groupId string) error {
+	_, err := jcRequest(ctx, c, http.MethodDelete, "/api/v2/policygroups/"+groupId, nil, nil, nil)
+	return err
+}
+
+// getPolicyGroupMemberIDs returns the IDs of the policies that are members of a policy group.
+func getPolicyGroupMemberIDs(ctx context.Context, c *jumpcloud.Client, groupId string) ([]string, error) {
+	return getGraphConnectionIDs(ctx, c, graphMembersPath("policy_group", groupId), nil)
+}
@@ -2,15 +2,24 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"io/fs"
+	"net/http"
+	"os"
 	"strings"
+	"time"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -43,7 +52,16 @@ func (p *jumpcloudProvider) Metadata(_ context.Context, _ provider.MetadataReque
 
 // jumpcloudProviderModel maps provider schema data to a Go type.
 type jumpcloudProviderModel struct {
-	ApiKey types.String `tfsdk:"api_key"`
+	ApiKey       types.String `tfsdk:"api_key"`
+	ClientID     types.String `tfsdk:"client_id"`
+	ClientSecret types.String `tfsdk:"client_secret"`
+	TokenURL     types.String `tfsdk:"token_url"`
+	Profile      types.String `tfsdk:"profile"`
+	OrgID        types.String `tfsdk:"org_id"`
+	ApiURL       types.String `tfsdk:"api_url"`
+	Region       types.String `tfsdk:"region"`
+	MaxRetries   types.Int64  `tfsdk:"max_retries"`
+	RetryMaxWait types.Int64  `tfsdk:"retry_max_wait"`
 }
 
 // Schema defines the provider-level schema for configuration data.
@@ -51,10 +69,79 @@ func (p *jumpcloudProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
-				Required:            true,
+				Optional:            true,
 				Sensitive:           true,
-				Description:         "The JumpCloud API key. This is a sensitive value and should be stored in environment variables, never in code.",
-				MarkdownDescription: "The JumpCloud API key. This is a sensitive value and should be stored in environment variables, never in code.",
+				Description:         "The JumpCloud API key. This is a sensitive value and should be stored in environment variables, never in code. Defaults to the JC_API_KEY environment variable, then to the api_key of the profile in the credentials file.",
+				MarkdownDescription: "The JumpCloud API key. This is a sensitive value and should be stored in environment variables, never in code. Defaults to the `JC_API_KEY` environment variable, then to the `api_key` of the `profile` in the credentials file.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("client_id"), path.MatchRoot("client_secret")),
+				},
+			},
+			"client_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The client ID of a JumpCloud service account, to authenticate with short-lived OAuth 2.0 tokens instead of an API key. Conflicts with api_key. Defaults to the JC_CLIENT_ID environment variable, then to the client_id of the profile in the credentials file.",
+				MarkdownDescription: "The client ID of a JumpCloud service account, to authenticate with short-lived OAuth 2.0 tokens instead of an API key. Conflicts with `api_key`. Defaults to the `JC_CLIENT_ID` environment variable, then to the `client_id` of the `profile` in the credentials file.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_secret")),
+					stringvalidator.ConflictsWith(path.MatchRoot("api_key")),
+				},
+			},
+			"client_secret": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				Description:         "The client secret of the JumpCloud service account. This is a sensitive value and should be stored in environment variables, never in code. Defaults to the JC_CLIENT_SECRET environment variable, then to the client_secret of the profile in the credentials file.",
+				MarkdownDescription: "The client secret of the JumpCloud service account. This is a sensitive value and should be stored in environment variables, never in code. Defaults to the `JC_CLIENT_SECRET` environment variable, then to the `client_secret` of the `profile` in the credentials file.",
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_id")),
+					stringvalidator.ConflictsWith(path.MatchRoot("api_key")),
+				},
+			},
+			"token_url": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The OAuth 2.0 token endpoint of the service account, ex. for a proxy. Defaults to the JC_TOKEN_URL environment variable, then to the token endpoint of the region. When api_url is set to a host other than a JumpCloud region, it defaults to the /oauth2/token path of that host.",
+				MarkdownDescription: "The OAuth 2.0 token endpoint of the service account, ex. for a proxy. Defaults to the `JC_TOKEN_URL` environment variable, then to the token endpoint of the `region`, `https://admin-oauth.id.jumpcloud.com/oauth2/token` or `https://admin-oauth.id.eu.jumpcloud.com/oauth2/token`. When `api_url` is set to a host other than a JumpCloud region, it defaults to the `/oauth2/token` path of that host.",
+			},
+			"profile": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The profile to read from the credentials file, ~/.jumpcloud/credentials or the JC_CREDENTIALS_FILE environment variable. Defaults to the JC_PROFILE environment variable, then to default.",
+				MarkdownDescription: "The profile to read from the credentials file, `~/.jumpcloud/credentials` or the `JC_CREDENTIALS_FILE` environment variable. Defaults to the `JC_PROFILE` environment variable, then to `default`.",
+			},
+			"org_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the organization to manage with a multi-tenant (MSP) administrator API key, sent in the x-org-id header of every request. Resources and data sources can override it with their own org_id. Defaults to the JC_ORG_ID environment variable, then to the org_id of the profile in the credentials file.",
+				MarkdownDescription: "The ID of the organization to manage with a multi-tenant (MSP) administrator API key, sent in the `x-org-id` header of every request. Resources and data sources can override it with their own `org_id`. Defaults to the `JC_ORG_ID` environment variable, then to the `org_id` of the `profile` in the credentials file.",
+			},
+			"api_url": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The base URL of the JumpCloud API, ex. a local mock server. Conflicts with region. Defaults to the JC_API_URL environment variable, then to the URL of the region.",
+				MarkdownDescription: "The base URL of the JumpCloud API, ex. a local mock server. Conflicts with `region`. Defaults to the `JC_API_URL` environment variable, then to the URL of the `region`.",
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("region")),
+				},
+			},
+			"region": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The JumpCloud region of the organization, us or eu. Defaults to the JC_REGION environment variable, then to us.",
+				MarkdownDescription: "The JumpCloud region of the organization, `us` or `eu`. Defaults to the `JC_REGION` environment variable, then to `us`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(regions()...),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "How many times a request rejected by the JumpCloud rate limits or failed by a server error is retried, 0 to never retry. Requests that create or change objects are only retried when they cannot have been processed. Defaults to 5.",
+				MarkdownDescription: "How many times a request rejected by the JumpCloud rate limits or failed by a server error is retried, `0` to never retry. Requests that create or change objects are only retried when they cannot have been processed. Defaults to `5`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The longest wait in seconds between two retries of a request. The wait doubles from 1 second with each retry, or follows the Retry-After header of the response, capped to this wait. Defaults to 30.",
+				MarkdownDescription: "The longest wait in seconds between two retries of a request. The wait doubles from 1 second with each retry, or follows the `Retry-After` header of the response, capped to this wait. Defaults to `30`.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
 			},
 		},
 	}
@@ -72,33 +159,152 @@ func (p *jumpcloudProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
-	// Set the API key from the configuration
-	var apiKey string
-	if !config.ApiKey.IsNull() {
-		apiKey = config.ApiKey.ValueString()
-	}
-
 	if config.ApiKey.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_key"),
-			"Missing JumpCloud API Key",
-			"The provider cannot create the JumpCloud API client as the required api_key has not been supplied. ",
+			"Unknown JumpCloud API Key",
+			"The provider cannot create the JumpCloud API client as there is an unknown configuration value for the JumpCloud API key. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the JC_API_KEY environment variable.",
 		)
 	}
+	if config.MaxRetries.IsUnknown() || config.RetryMaxWait.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown JumpCloud Retry Settings",
+			"The provider cannot create the JumpCloud API client as there is an unknown configuration value for the max_retries or retry_max_wait. "+
+				"Either target apply the source of the value first, or set the value statically in the configuration.",
+		)
+	}
+	if config.ClientID.IsUnknown() || config.ClientSecret.IsUnknown() || config.TokenURL.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown JumpCloud Service Account",
+			"The provider cannot create the JumpCloud API client as there is an unknown configuration value for the client_id, client_secret or token_url. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the JC_CLIENT_ID and JC_CLIENT_SECRET environment variables.",
+		)
+	}
+	if config.Profile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Unknown JumpCloud Profile",
+			"The provider cannot create the JumpCloud API client as there is an unknown configuration value for the credentials profile. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the JC_PROFILE environment variable.",
+		)
+	}
+	if config.OrgID.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("org_id"),
+			"Unknown JumpCloud Organization",
+			"The provider cannot create the JumpCloud API client as there is an unknown configuration value for the organization ID. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the JC_ORG_ID environment variable.",
+		)
+	}
+	if config.ApiURL.IsUnknown() || config.Region.IsUnknown() {
+		resp.Diagnostics.AddError(
+			"Unknown JumpCloud API URL",
+			"The provider cannot create the JumpCloud API client as there is an unknown configuration value for the api_url or region. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the JC_API_URL or JC_REGION environment variables.",
+		)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
+	// Read the profile of the credentials file, which supplies the settings missing
+	// from both the configuration and the environment
+	profile := config.Profile.ValueString()
+	if profile == "" {
+		profile = os.Getenv("JC_PROFILE")
+	}
+	explicitProfile := profile != ""
+	if !explicitProfile {
+		profile = defaultProfile
+	}
+	credentialsFile, err := credentialsFilePath()
+	var profileSettings map[string]string
+	if err == nil {
+		profileSettings, err = readCredentialsProfile(credentialsFile, profile)
+	}
+	// A missing file or default profile is fine when nothing is needed from it
+	if err != nil && (explicitProfile || !(errors.Is(err, fs.ErrNotExist) || errors.Is(err, errProfileNotFound))) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("profile"),
+			"Unable to Read JumpCloud Credentials File",
+			"The provider cannot read the profile "+profile+" from the credentials file: "+err.Error(),
+		)
+		return
+	}
+
+	// Resolve the API key or service account from the configuration, then the environment, then the credentials file
+	credentials, diags := resolveCredentials(config, profileSettings, fmt.Sprintf("profile %q of %s", profile, credentialsFile))
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Resolve the organization the same way, none means the organization of the API key
+	orgID := config.OrgID.ValueString()
+	if orgID == "" {
+		orgID = os.Getenv("JC_ORG_ID")
+	}
+	if orgID == "" {
+		orgID = profileSettings["org_id"]
+	}
+
+	// Resolve the API URL, a configured URL or region wins over the environment
+	apiURL, region := config.ApiURL.ValueString(), config.Region.ValueString()
+	if apiURL == "" && region == "" {
+		apiURL, region = os.Getenv("JC_API_URL"), os.Getenv("JC_REGION")
+	}
+	if apiURL == "" {
+		if region == "" {
+			region = defaultRegion
+		}
+		var found bool
+		if apiURL, found = regionURLs[region]; !found {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("region"),
+				"Invalid JumpCloud Region",
+				fmt.Sprintf("The region %q from the JC_REGION environment variable must be one of: %s.", region, strings.Join(regions(), ", ")),
+			)
+			return
+		}
+	}
+	hostURL, err := parseAPIURL(apiURL)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_url"),
+			"Invalid JumpCloud API URL",
+			"The provider cannot create the JumpCloud API client as the API URL is invalid: "+err.Error(),
+		)
+		return
+	}
+
+	// Resolve the token endpoint of service accounts, which is not on the API host of JumpCloud regions
+	tokenURL := config.TokenURL.ValueString()
+	if tokenURL == "" {
+		tokenURL = os.Getenv("JC_TOKEN_URL")
+	}
+	if tokenURL == "" {
+		tokenURL = defaultTokenURL(hostURL)
+	}
+	if err := checkTokenURL(tokenURL); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("token_url"),
+			"Invalid JumpCloud Token URL",
+			"The provider cannot create the JumpCloud API client as the token URL is invalid: "+err.Error(),
+		)
+		return
+	}
+
 	// If any of the expected configurations are missing, return
 	// errors with provider-specific guidance.
 
-	if apiKey == "" {
+	if credentials.apiKey == "" && credentials.clientID == "" {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_key"),
 			"Missing JumpCloud API Key",
-			"The provider cannot create the JumpCloud API client as there is an unknown configuration value for the JumpCloud API host. "+
-				"Either target apply the source of the value first, set the value statically in the configuration, or use the JC_API_KEY environment variable."+
+			"The provider cannot create the JumpCloud API client as there is a missing or empty value for the JumpCloud API key. "+
+				"Set the value in the configuration, use the JC_API_KEY environment variable, or add an api_key to the profile in the credentials file. "+
+				"To authenticate as a service account, set client_id and client_secret the same way instead. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
@@ -107,16 +313,20 @@ func (p *jumpcloudProvider) Configure(ctx context.Context, req provider.Configur
 	}
 
 	// Set provider-level log fields
-	ctx = tflog.SetField(ctx, "jumpcloud_host", jumpcloud.HostURL)
-	ctx = tflog.SetField(ctx, "api_key", apiKey)
-	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "api_key") // Mask the API key in the logs
+	ctx = tflog.SetField(ctx, "jumpcloud_host", hostURL.String())
+	ctx = tflog.SetField(ctx, "api_key", credentials.apiKey)
+	ctx = tflog.SetField(ctx, "client_id", credentials.clientID)
+	ctx = tflog.SetField(ctx, "client_secret", credentials.clientSecret)
+	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "api_key", "client_secret") // Mask the secrets in the logs
+	ctx = tflog.SetField(ctx, "credentials_source", credentials.source)
+	ctx = tflog.SetField(ctx, "org_id", orgID)
 	tflog.Debug(ctx, "Creating Jumpcloud client")
 
 	// Create a new jumpcloudProvider client using the configuration values
-	client, err := jumpcloud.NewClient(apiKey)
+	client, err := jumpcloud.NewClient(credentials.apiKey)
 
-	// If the client is not created, or the host is not the expected value, return an error
-	if err != nil || !strings.Contains(client.HostURL.String(), "console.jumpcloud.com") {
+	// If the client is not created, return an error
+	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Create JumpCloud API Client",
 			"An unexpected error occurred when creating the JumpCloud API client. "+
@@ -127,6 +337,42 @@ func (p *jumpcloudProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	// Service accounts send a bearer token instead of the API key
+	if credentials.clientID != "" {
+		delete(client.Headers, "x-api-key")
+		client.HTTPClient.Transport = &oauthTransport{
+			base:         http.DefaultTransport,
+			tokenURL:     tokenURL,
+			clientID:     credentials.clientID,
+			clientSecret: credentials.clientSecret,
+		}
+	}
+
+	// Retry throttled and failed requests of every resource, the timeout of the client now applies to each attempt
+	retries := &retryTransport{
+		base:       client.HTTPClient.Transport,
+		maxRetries: defaultMaxRetries,
+		maxWait:    defaultRetryMaxWait,
+		timeout:    client.HTTPClient.Timeout,
+	}
+	if retries.base == nil {
+		retries.base = http.DefaultTransport
+	}
+	if !config.MaxRetries.IsNull() {
+		retries.maxRetries = int(config.MaxRetries.ValueInt64())
+	}
+	if !config.RetryMaxWait.IsNull() {
+		retries.maxWait = time.Duration(config.RetryMaxWait.ValueInt64()) * time.Second
+	}
+	client.HTTPClient.Transport = retries
+	client.HTTPClient.Timeout = 0
+
+	// Send every request of both the v1 and v2 APIs to the configured host and organization
+	client.HostURL = hostURL
+	if orgID != "" {
+		client.Headers.Set(orgIDHeader, orgID)
+	}
+
 	// Make the JumpCloud client available during DataSource and Resource
 	//type Configure methods.
 	resp.DataSourceData = client
@@ -140,6 +386,12 @@ func (p *jumpcloudProvider) DataSources(_ context.Context) []func() datasource.D
 		NewjcUserGroupDataSource,
 		NewjcGroupLookupDataSource,
 		NewjcAppsDataSource,
+		NewjcAppSAMLMetadataDataSource,
+		NewjcPolicyTemplatesDataSource,
+		NewjcSystemsDataSource,
+		NewjcUsersDataSource,
+		NewjcUserDataSource,
+		NewjcUserGroupDetailsDataSource,
 	}
 }
 
@@ -148,5 +400,18 @@ func (p *jumpcloudProvider) Resources(_ context.Context) []func() resource.Resou
 	return []func() resource.Resource{
 		NewUserGroupsResource,
 		NewAppResource,
+		NewUserResource,
+		NewSystemGroupResource,
+		NewUserGroupMembershipResource,
+		NewAppAssociationResource,
+		NewGraphAssociationResource,
+		NewAppSAMLAttributesResource,
+		NewPolicyResource,
+		NewPolicyGroupResource,
+		NewPolicyAssociationResource,
+		NewCommandResource,
+		NewCommandTriggerResource,
+		NewSystemResource,
+		NewUserSystemBindingResource,
 	}
 }
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// defaultMaxRetries is how many times a failed request is retried when max_retries is not configured.
+	defaultMaxRetries = 5
+	// defaultRetryMaxWait is the longest wait between retries when retry_max_wait is not configured.
+	defaultRetryMaxWait = 30 * time.Second
+	// retryMinWait is the wait before the first retry, doubled for each following one.
+	retryMinWait = time.Second
+)
+
+// retryTransport retries requests rejected by the JumpCloud rate limits or failed by a server error,
+// waiting with a jittered exponential backoff or for as long as the Retry-After header asks, at most maxWait.
+//
+// Requests with side effects, POST and PATCH, are only retried when JumpCloud cannot have
+// processed them: a 429 response, or a connection that could not be opened.
+// Every attempt has its own timeout, so waiting for the rate limits never times out a request.
+type retryTransport struct {
+	base       http.RoundTripper
+	maxRetries int
+	maxWait    time.Duration
+	timeout    time.Duration
+}
+
+// RoundTrip sends the request, retrying it while it is safe and the retries are not exhausted.
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	for attempt := 0; ; attempt++ {
+		attemptReq, cancel, err := t.attemptRequest(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+		response, err := t.base.RoundTrip(attemptReq)
+		if !t.shouldRetry(req, response, err, attempt) {
+			if err != nil {
+				cancel()
+				return nil, err
+			}
+			response.Body = &cancelBody{ReadCloser: response.Body, cancel: cancel}
+			return response, nil
+		}
+		if response != nil {
+			// Drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, io.LimitReader(response.Body, 1<<16))
+			response.Body.Close()
+		}
+		cancel()
+		wait := t.backoff(response, attempt)
+		tflog.Debug(ctx, "Retrying JumpCloud API request", map[string]any{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"attempt": attempt + 1,
+			"status":  statusOf(response),
+			"error":   errorOf(err),
+			"wait":    wait.String(),
+		})
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// attemptRequest returns a copy of req with a fresh body and the timeout of one attempt.
+func (t *retryTransport) attemptRequest(req *http.Request, attempt int) (*http.Request, context.CancelFunc, error) {
+	var ctx context.Context
+	var cancel context.CancelFunc
+	if t.timeout > 0 {
+		ctx, cancel = context.WithTimeout(req.Context(), t.timeout)
+	} else {
+		ctx, cancel = context.WithCancel(req.Context())
+	}
+	attemptReq := req.Clone(ctx)
+	if attempt > 0 && req.Body != nil && req.Body != http.NoBody {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, nil, err
+		}
+		attemptReq.Body = body
+	}
+	return attemptReq, cancel, nil
+}
+
+// shouldRetry tells whether the outcome of an attempt is worth another one.
+func (t *retryTransport) shouldRetry(req *http.Request, response *http.Response, err error, attempt int) bool {
+	if attempt >= t.maxRetries || req.Context().Err() != nil {
+		return false
+	}
+	// The body of the request cannot be sent again
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	if err != nil {
+		return isIdempotent(req) || isDialError(err)
+	}
+	switch response.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req)
+	}
+	return false
+}
+
+// backoff returns how long to wait before the next attempt. A Retry-After header
+// asking for a longer wait than retry_max_wait allows is capped to retry_max_wait.
+func (t *retryTransport) backoff(response *http.Response, attempt int) time.Duration {
+	if response != nil {
+		if wait, ok := retryAfter(response.Header.Get("Retry-After")); ok {
+			return min(wait, t.maxWait)
+		}
+	}
+	wait := t.maxWait
+	if attempt < 30 && retryMinWait<<attempt < t.maxWait {
+		wait = retryMinWait << attempt
+	}
+	// Equal jitter, so parallel resources throttled together spread their retries
+	return wait/2 + time.Duration(rand.Int63n(int64(wait/2)+1))
+}
+
+// retryAfter parses a Retry-After header, either a number of seconds or an HTTP date.
+func retryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0), true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// isIdempotent tells whether sending the request twice has the same effect as sending it once.
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return req.Header.Get("Idempotency-Key") != ""
+}
+
+// isDialError tells whether err happened while opening the connection, before anything was sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+func statusOf(response *http.Response) int {
+	if response == nil {
+		return 0
+	}
+	return response.StatusCode
+}
+
+func errorOf(err error) string {
+	if err == nil {
+		return ""
+	}
+	return err.Error()
+}
+
+// cancelBody releases the timeout of an attempt once its response has been read.
+type cancelBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
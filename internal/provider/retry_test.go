@@ -0,0 +1,103 @@
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRetryTransport(t *testing.T) {
+	for name, test := range map[string]struct {
+		method     string
+		statuses   []int
+		retryAfter string
+		wantStatus int
+		wantCalls  int
+	}{
+		"get server errors":        {http.MethodGet, []int{503, 502, 200}, "", 200, 3},
+		"get retries exhausted":    {http.MethodGet, []int{500, 500, 500, 500}, "", 500, 3},
+		"get client error":         {http.MethodGet, []int{404}, "", 404, 1},
+		"post server error":        {http.MethodPost, []int{503, 200}, "", 503, 1},
+		"post rate limited":        {http.MethodPost, []int{429, 429, 201}, "0", 201, 3},
+		"retry-after too long":     {http.MethodPut, []int{429, 200}, "120", 200, 2},
+		"retry-after not a number": {http.MethodDelete, []int{429, 200}, "soon", 200, 2},
+	} {
+		t.Run(name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if r.Method == http.MethodPost && string(body) != `{"name": "example"}` {
+					t.Errorf("attempt %d: body = %q", calls+1, body)
+				}
+				status := test.statuses[calls]
+				calls++
+				if test.retryAfter != "" {
+					w.Header().Set("Retry-After", test.retryAfter)
+				}
+				w.WriteHeader(status)
+				_, _ = w.Write([]byte("attempt"))
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: &retryTransport{
+				base:       http.DefaultTransport,
+				maxRetries: 2,
+				maxWait:    10 * time.Millisecond,
+				timeout:    time.Second,
+			}}
+			req, err := http.NewRequest(test.method, server.URL, strings.NewReader(`{"name": "example"}`))
+			if err != nil {
+				t.Fatal(err)
+			}
+			response, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer response.Body.Close()
+			if body, _ := io.ReadAll(response.Body); string(body) != "attempt" {
+				t.Errorf("body = %q, want attempt", body)
+			}
+			if response.StatusCode != test.wantStatus {
+				t.Errorf("status = %d, want %d", response.StatusCode, test.wantStatus)
+			}
+			if calls != test.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, test.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryTransportDialError(t *testing.T) {
+	// Nothing listens on a closed server, so the request is never sent and even a POST is retried
+	server := httptest.NewServer(http.NotFoundHandler())
+	server.Close()
+
+	client := &http.Client{Transport: &retryTransport{
+		base:       http.DefaultTransport,
+		maxRetries: 2,
+		maxWait:    10 * time.Millisecond,
+	}}
+	start := time.Now()
+	if _, err := client.Post(server.URL, "application/json", strings.NewReader("{}")); err == nil {
+		t.Fatal("expected a connection error")
+	}
+	if elapsed := time.Since(start); elapsed < 10*time.Millisecond {
+		t.Errorf("returned after %s, expected two retries", elapsed)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	if wait, ok := retryAfter("7"); !ok || wait != 7*time.Second {
+		t.Errorf("retryAfter(7) = %s, %t", wait, ok)
+	}
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if wait, ok := retryAfter(date); !ok || wait <= 55*time.Second || wait > time.Minute {
+		t.Errorf("retryAfter(%s) = %s, %t", date, wait, ok)
+	}
+	if _, ok := retryAfter("later"); ok {
+		t.Error("retryAfter(later) should not parse")
+	}
+}
@@ -0,0 +1,447 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &jcSystemResource{}
+	_ resource.ResourceWithConfigure   = &jcSystemResource{}
+	_ resource.ResourceWithImportState = &jcSystemResource{}
+)
+
+// systemUserBindingAttrTypes are the attribute types of an element of user_bindings.
+var systemUserBindingAttrTypes = map[string]attr.Type{
+	"user_id":               types.StringType,
+	"sudo_enabled":          types.BoolType,
+	"sudo_without_password": types.BoolType,
+}
+
+// NewSystemResource is a helper function to simplify the provider implementation.
+func NewSystemResource() resource.Resource {
+	return &jcSystemResource{}
+}
+
+// jcSystemResource is the resource implementation.
+// Systems register themselves through the agent, so the resource can only be imported.
+type jcSystemResource struct {
+	client *jumpcloud.Client
+}
+
+// SystemResourceModel is the local model for this resource type.
+type SystemResourceModel struct {
+	ID                             types.String `tfsdk:"id"`
+	DisplayName                    types.String `tfsdk:"display_name"`
+	Hostname                       types.String `tfsdk:"hostname"`
+	Os                             types.String `tfsdk:"os"`
+	Description                    types.String `tfsdk:"description"`
+	Tags                           types.Set    `tfsdk:"tags"`
+	AllowSshRootLogin              types.Bool   `tfsdk:"allow_ssh_root_login"`
+	AllowSshPasswordAuthentication types.Bool   `tfsdk:"allow_ssh_password_authentication"`
+	AllowMultiFactorAuthentication types.Bool   `tfsdk:"allow_multi_factor_authentication"`
+	UserBindings                   types.Set    `tfsdk:"user_bindings"`
+	DeleteOnDestroy                types.Bool   `tfsdk:"delete_on_destroy"`
+	OrgID                          types.String `tfsdk:"org_id"`
+}
+
+// SystemUserBindingModel is a user bound to a system.
+type SystemUserBindingModel struct {
+	UserID              types.String `tfsdk:"user_id"`
+	SudoEnabled         types.Bool   `tfsdk:"sudo_enabled"`
+	SudoWithoutPassword types.Bool   `tfsdk:"sudo_without_password"`
+}
+
+// Metadata returns the resource type name.
+func (r *jcSystemResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system"
+}
+
+// Schema defines the schema for the resource.
+func (r *jcSystemResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "A device registered by the JumpCloud agent. Systems cannot be created by Terraform, import them to manage their settings",
+		MarkdownDescription: "A device registered by the JumpCloud agent. Systems cannot be created by Terraform, import them to manage their settings",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "System ID",
+				MarkdownDescription: "System ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"display_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The name of the system in the console",
+				MarkdownDescription: "The name of the system in the console",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"hostname": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The hostname reported by the agent",
+				MarkdownDescription: "The hostname reported by the agent",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"os": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The operating system reported by the agent",
+				MarkdownDescription: "The operating system reported by the agent",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "System Description",
+				MarkdownDescription: "System Description",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// Set attribute does not care about order
+			"tags": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Description:         "Tags of the system",
+				MarkdownDescription: "Tags of the system",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_ssh_root_login": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Allow root to log in over SSH",
+				MarkdownDescription: "Allow root to log in over SSH",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_ssh_password_authentication": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Allow users to log in over SSH with a password",
+				MarkdownDescription: "Allow users to log in over SSH with a password",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"allow_multi_factor_authentication": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Require users with MFA enabled to use it when logging in to the system",
+				MarkdownDescription: "Require users with MFA enabled to use it when logging in to the system",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_bindings": schema.SetNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Users bound to the system. Bindings not listed are removed, leave unset to leave user bindings unmanaged",
+				MarkdownDescription: "Users bound to the system. Bindings not listed are removed, leave unset to leave user bindings unmanaged",
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"user_id": schema.StringAttribute{
+							Required:            true,
+							Description:         "User ID",
+							MarkdownDescription: "User ID",
+						},
+						"sudo_enabled": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							Description:         "Give the user administrator rights on the system",
+							MarkdownDescription: "Give the user administrator rights on the system",
+						},
+						"sudo_without_password": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(false),
+							Description:         "Let the user run sudo without entering a password",
+							MarkdownDescription: "Let the user run sudo without entering a password",
+						},
+					},
+				},
+			},
+			"delete_on_destroy": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Remove the system from JumpCloud when the resource is destroyed, otherwise it is only removed from the state",
+				MarkdownDescription: "Remove the system from JumpCloud when the resource is destroyed, otherwise it is only removed from the state",
+			},
+			"org_id": orgIDResourceAttribute(),
+		},
+	}
+}
+
+// Create fails, systems can only be imported.
+func (r *jcSystemResource) Create(_ context.Context, _ resource.CreateRequest, resp *resource.CreateResponse) {
+	resp.Diagnostics.AddError(
+		"Systems Cannot Be Created",
+		"Systems register themselves when the JumpCloud agent is installed. Import the system by ID to manage its settings.",
+	)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jcSystemResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state SystemResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Get refreshed system value from JumpCloud
+	tflog.Info(ctx, fmt.Sprintf("Looking Up System ID: %s", state.ID.ValueString()))
+	s, err := getSystem(ctx, r.client, state.ID.ValueString())
+	if isNotFound(err) {
+		// The system was removed outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Jumpcloud System",
+			"Could not read Jumpcloud System ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	newState, diags := r.readState(ctx, s)
+	resp.Diagnostics.Append(diags...)
+	newState.OrgID = state.OrgID
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Imported systems are never deleted unless configured otherwise
+	newState.DeleteOnDestroy = state.DeleteOnDestroy
+	if newState.DeleteOnDestroy.IsNull() {
+		newState.DeleteOnDestroy = types.BoolValue(false)
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *jcSystemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan
+	var plan SystemResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	systemId := plan.ID.ValueString()
+
+	// Update the system settings
+	payload := systemPayload{
+		DisplayName:                    plan.DisplayName.ValueString(),
+		Description:                    plan.Description.ValueString(),
+		Tags:                           []string{},
+		AllowSshRootLogin:              plan.AllowSshRootLogin.ValueBool(),
+		AllowSshPasswordAuthentication: plan.AllowSshPasswordAuthentication.ValueBool(),
+		AllowMultiFactorAuthentication: plan.AllowMultiFactorAuthentication.ValueBool(),
+	}
+	resp.Diagnostics.Append(plan.Tags.ElementsAs(ctx, &payload.Tags, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	s, err := updateSystem(ctx, r.client, systemId, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Modifying System",
+			"Could not modify System ID "+systemId+": "+err.Error(),
+		)
+		return
+	}
+
+	// Reconcile user bindings when they are set in the configuration
+	resp.Diagnostics.Append(r.setUserBindings(ctx, req.Config, systemId, plan.UserBindings)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Read back the updated bindings
+	newState, diags := r.readState(ctx, s)
+	resp.Diagnostics.Append(diags...)
+	newState.OrgID = plan.OrgID
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	newState.DeleteOnDestroy = plan.DeleteOnDestroy
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the system from JumpCloud if delete_on_destroy is set and removes the Terraform state on success.
+func (r *jcSystemResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state SystemResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	if !state.DeleteOnDestroy.ValueBool() {
+		tflog.Info(ctx, fmt.Sprintf("Leaving System ID %s in JumpCloud, delete_on_destroy is not set", state.ID.ValueString()))
+		return
+	}
+
+	// Delete existing system. This object will be purged from the state file so there is no need to return values
+	err := deleteSystem(ctx, r.client, state.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting System",
+			"Could not delete system, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jcSystemResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of resource
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource state from live resources via their ID attribute, optionally prefixed with org_id:.
+func (r *jcSystemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	_, id := importOrgID(ctx, req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// setUserBindings makes the planned users the only users bound to the system, with the planned sudo settings.
+// Bindings are only reconciled when user_bindings is set in the configuration.
+func (r *jcSystemResource) setUserBindings(ctx context.Context, config tfsdk.Config, systemId string, bindings types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var configured types.Set
+	diags.Append(config.GetAttribute(ctx, path.Root("user_bindings"), &configured)...)
+	if diags.HasError() || configured.IsNull() {
+		return diags
+	}
+	var want []SystemUserBindingModel
+	diags.Append(bindings.ElementsAs(ctx, &want, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Add missing bindings, update bindings whose sudo settings changed and remove bindings that are no longer planned
+	edges := []graphEdge{}
+	for _, binding := range want {
+		edges = append(edges, graphEdge{
+			ID:         binding.UserID.ValueString(),
+			Attributes: sudoAttributes(binding.SudoEnabled.ValueBool(), binding.SudoWithoutPassword.ValueBool()),
+		})
+	}
+	sudoMatches := func(want, current map[string]any) bool {
+		wantEnabled, wantWithoutPassword := parseSudoAttributes(want)
+		enabled, withoutPassword := parseSudoAttributes(current)
+		return enabled == wantEnabled && withoutPassword == wantWithoutPassword
+	}
+	err := setGraphConnections(ctx, r.client, graphAssociationsPath("system", systemId), url.Values{"targets": {"user"}}, "user", edges, sudoMatches)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("user_bindings"),
+			"Error Binding Users to System",
+			"Could not update the users bound to System ID "+systemId+": "+err.Error(),
+		)
+	}
+	return diags
+}
+
+// readState converts a system to the resource state, reading its user bindings from JumpCloud.
+func (r *jcSystemResource) readState(ctx context.Context, s system) (state SystemResourceModel, diags diag.Diagnostics) {
+	connections, err := listGraphConnections(ctx, r.client, graphAssociationsPath("system", s.ID), url.Values{"targets": {"user"}})
+	if err != nil {
+		diags.AddError(
+			"Error Reading System Users",
+			"Could not read the users bound to System ID "+s.ID+": "+err.Error(),
+		)
+		return state, diags
+	}
+
+	state = SystemResourceModel{
+		ID:                             types.StringValue(s.ID),
+		DisplayName:                    types.StringValue(s.DisplayName),
+		Hostname:                       types.StringValue(s.Hostname),
+		Os:                             types.StringValue(s.Os),
+		Description:                    types.StringValue(s.Description),
+		AllowSshRootLogin:              types.BoolValue(s.AllowSshRootLogin),
+		AllowSshPasswordAuthentication: types.BoolValue(s.AllowSshPasswordAuthentication),
+		AllowMultiFactorAuthentication: types.BoolValue(s.AllowMultiFactorAuthentication),
+	}
+	if s.Tags == nil {
+		s.Tags = []string{}
+	}
+	tags, d := types.SetValueFrom(ctx, types.StringType, s.Tags)
+	diags.Append(d...)
+	state.Tags = tags
+
+	bindings := []SystemUserBindingModel{}
+	for _, c := range connections {
+		enabled, withoutPassword := parseSudoAttributes(c.Attributes)
+		bindings = append(bindings, SystemUserBindingModel{
+			UserID:              types.StringValue(c.To.ID),
+			SudoEnabled:         types.BoolValue(enabled),
+			SudoWithoutPassword: types.BoolValue(withoutPassword),
+		})
+	}
+	userBindings, d := types.SetValueFrom(ctx, types.ObjectType{AttrTypes: systemUserBindingAttrTypes}, bindings)
+	diags.Append(d...)
+	state.UserBindings = userBindings
+	return state, diags
+}
@@ -0,0 +1,92 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccResourceSystem_CreateFails(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Systems register through the agent and can only be imported
+				Config: providerConfig + `resource "jumpcloud_system" "system" {
+											display_name = "system_terraform_test"
+										}`,
+				ExpectError: regexp.MustCompile("Systems Cannot Be Created"),
+			},
+		},
+	})
+}
+
+// TestAccResourceSystem_ImportAndUpdate changes the settings of a registered system,
+// set JC_TEST_SYSTEM_ID to a system dedicated to testing to run it.
+func TestAccResourceSystem_ImportAndUpdate(t *testing.T) {
+	systemId := os.Getenv("JC_TEST_SYSTEM_ID")
+	if systemId == "" {
+		t.Skip("JC_TEST_SYSTEM_ID must be set to a registered system to import")
+	}
+	user := `resource "jumpcloud_user" "admin" {
+				username   = "system_terraform_test"
+				email      = "system_terraform_test@example.com"
+				first_name = "System"
+				last_name  = "Test"
+			}
+			`
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Import the system as it is
+				Config:             providerConfig + user + `resource "jumpcloud_system" "system" {}`,
+				ResourceName:       "jumpcloud_system.system",
+				ImportState:        true,
+				ImportStateId:      systemId,
+				ImportStatePersist: true,
+				ImportStateCheck: func(states []*terraform.InstanceState) error {
+					if len(states) != 1 || states[0].ID != systemId {
+						return fmt.Errorf("expected system %s to be imported, got %v", systemId, states)
+					}
+					return nil
+				},
+			},
+			{
+				// Allow root login and bind the user with sudo
+				Config: providerConfig + user + `resource "jumpcloud_system" "system" {
+											allow_ssh_root_login = true
+											user_bindings = [{
+												user_id      = jumpcloud_user.admin.id
+												sudo_enabled = true
+											}]
+										}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpcloud_system.system", "id", systemId),
+					resource.TestCheckResourceAttr("jumpcloud_system.system", "allow_ssh_root_login", "true"),
+					resource.TestCheckResourceAttr("jumpcloud_system.system", "user_bindings.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("jumpcloud_system.system", "user_bindings.*.user_id", "jumpcloud_user.admin", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("jumpcloud_system.system", "user_bindings.*", map[string]string{
+						"sudo_enabled":          "true",
+						"sudo_without_password": "false",
+					}),
+				),
+			},
+			{
+				// Disallow root login and remove the binding again
+				Config: providerConfig + user + `resource "jumpcloud_system" "system" {
+											allow_ssh_root_login = false
+											user_bindings        = []
+										}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpcloud_system.system", "allow_ssh_root_login", "false"),
+					resource.TestCheckResourceAttr("jumpcloud_system.system", "user_bindings.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,406 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &jcSystemGroupResource{}
+	_ resource.ResourceWithConfigure   = &jcSystemGroupResource{}
+	_ resource.ResourceWithImportState = &jcSystemGroupResource{}
+)
+
+// NewSystemGroupResource is a helper function to simplify the provider implementation.
+func NewSystemGroupResource() resource.Resource {
+	return &jcSystemGroupResource{}
+}
+
+// jcSystemGroupResource is the resource implementation.
+type jcSystemGroupResource struct {
+	client *jumpcloud.Client
+}
+
+// SystemGroupResourceModel is the local model for this resource type.
+type SystemGroupResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	Type        types.String `tfsdk:"type"`
+	Members     types.Set    `tfsdk:"members"`
+	OrgID       types.String `tfsdk:"org_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *jcSystemGroupResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_group"
+}
+
+// Schema defines the schema for the resource.
+func (r *jcSystemGroupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "System Group ID",
+				MarkdownDescription: "System Group ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "System Group Name",
+				MarkdownDescription: "System Group Name",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "System Group Description",
+				MarkdownDescription: "System Group Description",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Always system_group",
+				MarkdownDescription: "Always `system_group`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"members": schema.SetAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "System IDs or hostnames that are static members of this group. Membership is not managed when omitted",
+				MarkdownDescription: "System IDs or hostnames that are static members of this group. Membership is not managed when omitted.",
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": orgIDResourceAttribute(),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *jcSystemGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan SystemGroupResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	// Get the member IDs or hostnames from the plan
+	var planMembers []string
+	if !plan.Members.IsUnknown() {
+		resp.Diagnostics.Append(plan.Members.ElementsAs(ctx, &planMembers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Resolve hostnames to system IDs before creating anything
+	memberSystemIds, err := r.resolveMembers(ctx, planMembers)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("members"),
+			"Error resolving system group members",
+			err.Error(),
+		)
+		return
+	}
+
+	// Create new group, check for errors
+	g, err := createSystemGroup(ctx, r.client, systemGroup{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating system group",
+			"Could not create system group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Created Jumpcloud System Group: %s", g.Name))
+
+	// Save the ID right away so a failure below does not orphan the group
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), g.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org_id"), plan.OrgID)...)
+
+	// Add members
+	for _, systemId := range memberSystemIds {
+		if err := addSystemToGroup(ctx, r.client, g.ID, systemId); err != nil {
+			resp.Diagnostics.AddError(
+				"Error adding system to group",
+				"Could not add system "+systemId+" to group, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	state, err := r.readState(ctx, g.ID, planMembers)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Jumpcloud System Group",
+			"Could not read Jumpcloud System Group ID "+g.ID+": "+err.Error(),
+		)
+		return
+	}
+	state.OrgID = plan.OrgID
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jcSystemGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state SystemGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Keep members in the same form (ID or hostname) they were written in
+	var priorMembers []string
+	if !state.Members.IsNull() && !state.Members.IsUnknown() {
+		resp.Diagnostics.Append(state.Members.ElementsAs(ctx, &priorMembers, false)...)
+	}
+
+	// Get refreshed group value from JumpCloud
+	tflog.Info(ctx, fmt.Sprintf("Looking Up System Group ID: %s", state.ID.ValueString()))
+	newState, err := r.readState(ctx, state.ID.ValueString(), priorMembers)
+	if isNotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Jumpcloud System Group",
+			"Could not read Jumpcloud System Group ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	newState.OrgID = state.OrgID
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *jcSystemGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan, config and state
+	var plan, state SystemGroupResourceModel
+	var configMembers types.Set
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("members"), &configMembers)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	groupId := state.ID.ValueString()
+
+	// Update group, reference the state's group Id
+	_, err := updateSystemGroup(ctx, r.client, groupId, systemGroup{
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Modifying System Group",
+			"Could not modify System Group ID "+groupId+": "+err.Error(),
+		)
+		return
+	}
+
+	// Membership is only reconciled when members are set in the configuration
+	var planMembers []string
+	if !configMembers.IsNull() {
+		resp.Diagnostics.Append(plan.Members.ElementsAs(ctx, &planMembers, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		wantedIds, err := r.resolveMembers(ctx, planMembers)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("members"),
+				"Error resolving system group members",
+				err.Error(),
+			)
+			return
+		}
+
+		// Get current group membership
+		currentIds, err := getSystemGroupMemberIDs(ctx, r.client, groupId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading System Group Members",
+				"Could not read members of System Group ID "+groupId+": "+err.Error(),
+			)
+			return
+		}
+
+		// If planned member not in current members, add to group
+		for _, systemId := range wantedIds {
+			if !slices.Contains(currentIds, systemId) {
+				if err := addSystemToGroup(ctx, r.client, groupId, systemId); err != nil {
+					resp.Diagnostics.AddError(
+						"Error Adding System to Group",
+						"Could not add system "+systemId+" to group, unexpected error: "+err.Error(),
+					)
+					return
+				}
+			}
+		}
+
+		// If current member not in planned members, remove from group
+		for _, systemId := range currentIds {
+			if !slices.Contains(wantedIds, systemId) {
+				if err := removeSystemFromGroup(ctx, r.client, groupId, systemId); err != nil {
+					resp.Diagnostics.AddError(
+						"Error Removing System from Group",
+						"Could not remove system "+systemId+" from group, unexpected error: "+err.Error(),
+					)
+					return
+				}
+			}
+		}
+	} else {
+		resp.Diagnostics.Append(state.Members.ElementsAs(ctx, &planMembers, false)...)
+	}
+
+	// Get the updated group
+	newState, err := r.readState(ctx, groupId, planMembers)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Jumpcloud System Group",
+			"Could not read Jumpcloud System Group ID "+groupId+": "+err.Error(),
+		)
+		return
+	}
+	newState.OrgID = plan.OrgID
+
+	// Set state to fully populated data
+	diags := resp.State.Set(ctx, newState)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *jcSystemGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state SystemGroupResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Delete existing group. This object will be purged from the state file so there is no need to return values
+	err := deleteSystemGroup(ctx, r.client, state.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting System Group",
+			"Could not delete system group, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jcSystemGroupResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of resource
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource state from live resources via their ID attribute, optionally prefixed with org_id:.
+func (r *jcSystemGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Retrieve import ID and save to id attribute
+	_, id := importOrgID(ctx, req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// resolveMembers maps configured member IDs or hostnames to system IDs.
+func (r *jcSystemGroupResource) resolveMembers(ctx context.Context, members []string) ([]string, error) {
+	var systemIds []string
+	for _, member := range members {
+		systemId, err := resolveSystemID(ctx, r.client, member)
+		if err != nil {
+			return nil, err
+		}
+		systemIds = append(systemIds, systemId)
+	}
+	return systemIds, nil
+}
+
+// readState reads the group and its members from JumpCloud.
+// Members listed by hostname in priorMembers are reported by hostname, all others by ID.
+func (r *jcSystemGroupResource) readState(ctx context.Context, groupId string, priorMembers []string) (SystemGroupResourceModel, error) {
+	group, err := getSystemGroup(ctx, r.client, groupId)
+	if err != nil {
+		return SystemGroupResourceModel{}, err
+	}
+	memberIds, err := getSystemGroupMemberIDs(ctx, r.client, groupId)
+	if err != nil {
+		return SystemGroupResourceModel{}, err
+	}
+
+	var members []attr.Value // This is the terraform structure requirement
+	for _, systemId := range memberIds {
+		member := systemId
+		if !slices.Contains(priorMembers, systemId) {
+			if s, err := getSystem(ctx, r.client, systemId); err == nil && slices.Contains(priorMembers, s.Hostname) {
+				member = s.Hostname
+			}
+		}
+		members = append(members, types.StringValue(member))
+	}
+	returnedMembers, _ := types.SetValue(types.StringType, members)
+
+	return SystemGroupResourceModel{
+		ID:          types.StringValue(group.ID),
+		Name:        types.StringValue(group.Name),
+		Description: types.StringValue(group.Description),
+		Type:        types.StringValue(group.Type),
+		Members:     returnedMembers,
+	}, nil
+}
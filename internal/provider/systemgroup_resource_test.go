@@ -0,0 +1,37 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceSystemGroup_CreateGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create a new system group and verify that aspects of it are correct
+				Config: providerConfig + `resource "jumpcloud_system_group" "new_system_group" {
+											name        = "new_system_group_terraform_test"
+											description = "This group made via terraform test"
+										}`,
+				// Compose multiple test checks to verify the resource
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpcloud_system_group.new_system_group",
+						"name",
+						"new_system_group_terraform_test"),
+					resource.TestCheckResourceAttr("jumpcloud_system_group.new_system_group",
+						"type",
+						"system_group"),
+				),
+			},
+			{
+				// Import the group by ID
+				ResourceName:      "jumpcloud_system_group.new_system_group",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+)
+
+// systemGroup is the structure of a v2 System Group object.
+type systemGroup struct {
+	ID               string `json:"id,omitempty"`
+	Name             string `json:"name"`
+	Description      string `json:"description"`
+	Type             string `json:"type,omitempty"`
+	MembershipMethod string `json:"membershipMethod,omitempty"`
+}
+
+// getSystemGroup returns a system group by ID.
+func getSystemGroup(ctx context.Context, c *jumpcloud.Client, groupId string) (group systemGroup, err error) {
+	_, err = jcRequest(ctx, c, http.MethodGet, "/api/v2/systemgroups/"+groupId, nil, nil, &group)
+	return group, err
+}
+
+// createSystemGroup creates a new system group.
+func createSystemGroup(ctx context.Context, c *jumpcloud.Client, newGroup systemGroup) (group systemGroup, err error) {
+	_, err = jcRequest(ctx, c, http.MethodPost, "/api/v2/systemgroups", nil, newGroup, &group)
+	return group, err
+}
+
+// updateSystemGroup updates the name and description of a system group.
+func updateSystemGroup(ctx context.Context, c *jumpcloud.Client, groupId string, updatedGroup systemGroup) (group systemGroup, err error) {
+	_, err = jcRequest(ctx, c, http.MethodPut, "/api/v2/systemgroups/"+groupId, nil, updatedGroup, &group)
+	return group, err
+}
+
+// deleteSystemGroup deletes a system group.
+func deleteSystemGroup(ctx context.Context, c *jumpcloud.Client, groupId string) error {
+	_, err := jcRequest(ctx, c, http.MethodDelete, "/api/v2/systemgroups/"+groupId, nil, nil, nil)
+	return err
+}
+
+// getSystemGroupMemberIDs returns the IDs of the systems that are members of a system group.
+func getSystemGroupMemberIDs(ctx context.Context, c *jumpcloud.Client, groupId string) ([]string, error) {
+	members, err := listGraphConnections(ctx, c, "/api/v2/systemgroups/"+groupId+"/members", nil)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, member := range members {
+		ids = append(ids, member.To.ID)
+	}
+	return ids, nil
+}
+
+// addSystemToGroup adds a system to a system group.
+func addSystemToGroup(ctx context.Context, c *jumpcloud.Client, groupId, systemId string) error {
+	return modifyGraphConnection(ctx, c, "/api/v2/systemgroups/"+groupId+"/members", "add", "system", systemId, nil)
+}
+
+// removeSystemFromGroup removes a system from a system group.
+func removeSystemFromGroup(ctx context.Context, c *jumpcloud.Client, groupId, systemId string) error {
+	return modifyGraphConnection(ctx, c, "/api/v2/systemgroups/"+groupId+"/members", "remove", "system", systemId, nil)
+}
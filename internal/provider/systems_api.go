@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+)
+
+// system is the structure of a v1 System object.
+type system struct {
+	ID                             string   `json:"_id,omitempty"`
+	DisplayName                    string   `json:"displayName,omitempty"`
+	Hostname                       string   `json:"hostname,omitempty"`
+	Os                             string   `json:"os,omitempty"`
+	OsFamily                       string   `json:"osFamily,omitempty"`
+	Version                        string   `json:"version,omitempty"`
+	AgentVersion                   string   `json:"agentVersion,omitempty"`
+	SerialNumber                   string   `json:"serialNumber,omitempty"`
+	LastContact                    string   `json:"lastContact,omitempty"`
+	Active                         bool     `json:"active,omitempty"`
+	Description                    string   `json:"description,omitempty"`
+	Tags                           []string `json:"tags,omitempty"`
+	AllowSshRootLogin              bool     `json:"allowSshRootLogin,omitempty"`
+	AllowSshPasswordAuthentication bool     `json:"allowSshPasswordAuthentication,omitempty"`
+	AllowMultiFactorAuthentication bool     `json:"allowMultiFactorAuthentication,omitempty"`
+}
+
+// systemPayload is the body sent when updating the mutable settings of a system.
+// Fields are not omitempty so that disabling a setting in the configuration disables it in JumpCloud.
+type systemPayload struct {
+	DisplayName                    string   `json:"displayName"`
+	Description                    string   `json:"description"`
+	Tags                           []string `json:"tags"`
+	AllowSshRootLogin              bool     `json:"allowSshRootLogin"`
+	AllowSshPasswordAuthentication bool     `json:"allowSshPasswordAuthentication"`
+	AllowMultiFactorAuthentication bool     `json:"allowMultiFactorAuthentication"`
+}
+
+// systemSearchResult is the v1 list response for systems.
+type systemSearchResult struct {
+	TotalCount int      `json:"totalCount"`
+	Results    []system `json:"results"`
+}
+
+// getSystem returns a system by ID.
+func getSystem(ctx context.Context, c *jumpcloud.Client, systemId string) (s system, err error) {
+	_, err = jcRequest(ctx, c, http.MethodGet, "/api/systems/"+systemId, nil, nil, &s)
+	return s, err
+}
+
+// listSystems returns every system matching the filters, following pagination.
+// Filters use the v1 filter syntax, ex. osFamily:$eq:darwin.
+func listSystems(ctx context.Context, c *jumpcloud.Client, filters []string) ([]system, error) {
+	var systems []system
+	params := url.Values{
+		"limit": {strconv.Itoa(graphPageSize)},
+	}
+	if len(filters) > 0 {
+		params["filter"] = filters
+	}
+	for skip := 0; ; skip += graphPageSize {
+		var page systemSearchResult
+		params.Set("skip", strconv.Itoa(skip))
+		if _, err := jcRequest(ctx, c, http.MethodGet, "/api/systems", params, nil, &page); err != nil {
+			return nil, err
+		}
+		systems = append(systems, page.Results...)
+		if len(page.Results) < graphPageSize || len(systems) >= page.TotalCount {
+			return systems, nil
+		}
+	}
+}
+
+// updateSystem changes the mutable settings of a system.
+func updateSystem(ctx context.Context, c *jumpcloud.Client, systemId string, payload systemPayload) (s system, err error) {
+	_, err = jcRequest(ctx, c, http.MethodPut, "/api/systems/"+systemId, nil, payload, &s)
+	return s, err
+}
+
+// deleteSystem removes a system from JumpCloud. The agent has to be reinstalled to register the device again.
+func deleteSystem(ctx context.Context, c *jumpcloud.Client, systemId string) error {
+	_, err := jcRequest(ctx, c, http.MethodDelete, "/api/systems/"+systemId, nil, nil, nil)
+	return err
+}
+
+// sudoAttributes returns the graph edge attributes granting sudo on a system to a user or user group.
+func sudoAttributes(enabled, withoutPassword bool) map[string]any {
+	return map[string]any{
+		"sudo": map[string]any{
+			"enabled":         enabled,
+			"withoutPassword": withoutPassword,
+		},
+	}
+}
+
+// parseSudoAttributes reads the sudo settings from graph edge attributes, missing settings are false.
+func parseSudoAttributes(attributes map[string]any) (enabled, withoutPassword bool) {
+	sudo, _ := attributes["sudo"].(map[string]any)
+	enabled, _ = sudo["enabled"].(bool)
+	withoutPassword, _ = sudo["withoutPassword"].(bool)
+	return enabled, withoutPassword
+}
+
+// findSystemIDByHostname returns the ID of the single system with the given hostname.
+// An error is returned if no system or more than one system matches.
+func findSystemIDByHostname(ctx context.Context, c *jumpcloud.Client, hostname string) (string, error) {
+	var result systemSearchResult
+	params := url.Values{
+		"filter": {"hostname:$eq:" + hostname},
+		"fields": {"_id hostname"},
+		"limit":  {"2"},
+	}
+	_, err := jcRequest(ctx, c, http.MethodGet, "/api/systems", params, nil, &result)
+	if err != nil {
+		return "", err
+	}
+	switch len(result.Results) {
+	case 0:
+		return "", fmt.Errorf("no system found with hostname %q", hostname)
+	case 1:
+		return result.Results[0].ID, nil
+	default:
+		return "", fmt.Errorf("%d systems found with hostname %q, expected exactly one", result.TotalCount, hostname)
+	}
+}
+
+// resolveSystemID returns systemRef unchanged if it is already an ID, otherwise looks it up by hostname.
+func resolveSystemID(ctx context.Context, c *jumpcloud.Client, systemRef string) (string, error) {
+	if isObjectID(systemRef) {
+		return systemRef, nil
+	}
+	return findSystemIDByHostname(ctx, c, systemRef)
+}
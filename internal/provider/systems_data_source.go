@@ -0,0 +1,297 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &jcSystemsDataSource{}
+	_ datasource.DataSourceWithConfigure = &jcSystemsDataSource{}
+)
+
+// jcSystemModel maps a system to a Go type.
+type jcSystemModel struct {
+	ID           types.String `tfsdk:"id"`
+	DisplayName  types.String `tfsdk:"display_name"`
+	Hostname     types.String `tfsdk:"hostname"`
+	Os           types.String `tfsdk:"os"`
+	OsFamily     types.String `tfsdk:"os_family"`
+	OsVersion    types.String `tfsdk:"os_version"`
+	AgentVersion types.String `tfsdk:"agent_version"`
+	SerialNumber types.String `tfsdk:"serial_number"`
+	LastContact  types.String `tfsdk:"last_contact"`
+	Active       types.Bool   `tfsdk:"active"`
+}
+
+// jcSystemsDataSourceModel maps the data source schema data.
+type jcSystemsDataSourceModel struct {
+	Hostname          types.String    `tfsdk:"hostname"`
+	Os                types.String    `tfsdk:"os"`
+	OsFamily          types.String    `tfsdk:"os_family"`
+	AgentVersion      types.String    `tfsdk:"agent_version"`
+	SerialNumber      types.String    `tfsdk:"serial_number"`
+	SystemGroupID     types.String    `tfsdk:"system_group_id"`
+	LastContactBefore types.String    `tfsdk:"last_contact_before"`
+	LastContactAfter  types.String    `tfsdk:"last_contact_after"`
+	Systems           []jcSystemModel `tfsdk:"systems"`
+	OrgID             types.String    `tfsdk:"org_id"`
+}
+
+// NewjcSystemsDataSource is a helper function to simplify the provider implementation.
+func NewjcSystemsDataSource() datasource.DataSource {
+	return &jcSystemsDataSource{}
+}
+
+// jcSystemsDataSource is the data source implementation.
+// This struct accepts a client pointer to the JumpCloud Go client so terraform can make its changes to the system.
+type jcSystemsDataSource struct {
+	client *jumpcloud.Client
+}
+
+// Metadata returns the data source type name.
+func (d *jcSystemsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_systems"
+}
+
+// Schema defines the schema for the data source.
+func (d *jcSystemsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"hostname": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return systems with this hostname",
+				MarkdownDescription: "Only return systems with this hostname",
+			},
+			"os": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return systems running this OS, ex. Mac OS X or Ubuntu",
+				MarkdownDescription: "Only return systems running this OS, ex. `Mac OS X` or `Ubuntu`",
+			},
+			"os_family": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return systems of this OS family, ex. darwin, linux or windows",
+				MarkdownDescription: "Only return systems of this OS family, ex. `darwin`, `linux` or `windows`",
+			},
+			"agent_version": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return systems running this agent version",
+				MarkdownDescription: "Only return systems running this agent version",
+			},
+			"serial_number": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return the system with this serial number",
+				MarkdownDescription: "Only return the system with this serial number",
+			},
+			"system_group_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return systems that are members of this system group",
+				MarkdownDescription: "Only return systems that are members of this system group",
+			},
+			"last_contact_before": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return systems last seen before this RFC3339 time, including systems that never contacted JumpCloud, ex. timeadd(plantimestamp(), \"-720h\")",
+				MarkdownDescription: "Only return systems last seen before this RFC3339 time, including systems that never contacted JumpCloud, ex. `timeadd(plantimestamp(), \"-720h\")`",
+			},
+			"last_contact_after": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return systems last seen after this RFC3339 time",
+				MarkdownDescription: "Only return systems last seen after this RFC3339 time",
+			},
+			"systems": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "A list of Jumpcloud Systems",
+				MarkdownDescription: "A list of Jumpcloud Systems",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The ID of the System",
+							MarkdownDescription: "The ID of the System",
+						},
+						"display_name": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The name of the System in the console",
+							MarkdownDescription: "The name of the System in the console",
+						},
+						"hostname": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The hostname reported by the agent",
+							MarkdownDescription: "The hostname reported by the agent",
+						},
+						"os": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The operating system reported by the agent",
+							MarkdownDescription: "The operating system reported by the agent",
+						},
+						"os_family": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The OS family, ex. darwin, linux or windows",
+							MarkdownDescription: "The OS family, ex. `darwin`, `linux` or `windows`",
+						},
+						"os_version": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The version of the operating system",
+							MarkdownDescription: "The version of the operating system",
+						},
+						"agent_version": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The version of the JumpCloud agent",
+							MarkdownDescription: "The version of the JumpCloud agent",
+						},
+						"serial_number": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The hardware serial number",
+							MarkdownDescription: "The hardware serial number",
+						},
+						"last_contact": schema.StringAttribute{
+							Computed:            true,
+							Description:         "When the agent last contacted JumpCloud, empty if it never did",
+							MarkdownDescription: "When the agent last contacted JumpCloud, empty if it never did",
+						},
+						"active": schema.BoolAttribute{
+							Computed:            true,
+							Description:         "Whether the agent is currently connected",
+							MarkdownDescription: "Whether the agent is currently connected",
+						},
+					},
+				},
+			},
+			"org_id": orgIDDataSourceAttribute(),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *jcSystemsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state jcSystemsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = withOrg(d.client, state.OrgID)
+
+	// Parse the last contact window
+	before := parseTimeFilter(state.LastContactBefore, path.Root("last_contact_before"), &resp.Diagnostics)
+	after := parseTimeFilter(state.LastContactAfter, path.Root("last_contact_after"), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Exact matches are filtered by JumpCloud
+	var filters []string
+	for field, value := range map[string]types.String{
+		"hostname":     state.Hostname,
+		"os":           state.Os,
+		"osFamily":     state.OsFamily,
+		"agentVersion": state.AgentVersion,
+		"serialNumber": state.SerialNumber,
+	} {
+		if !value.IsNull() {
+			filters = append(filters, field+":$eq:"+value.ValueString())
+		}
+	}
+	slices.Sort(filters)
+	systems, err := listSystems(ctx, d.client, filters)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Jumpcloud Systems",
+			err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Read Jumpcloud Systems: %v", len(systems)))
+
+	// Group membership is filtered locally
+	var memberIds []string
+	if !state.SystemGroupID.IsNull() {
+		memberIds, err = getSystemGroupMemberIDs(ctx, d.client, state.SystemGroupID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Jumpcloud System Group Members",
+				"Could not read members of System Group ID "+state.SystemGroupID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Map response to state
+	state.Systems = []jcSystemModel{}
+	for _, s := range systems {
+		if !state.SystemGroupID.IsNull() && !slices.Contains(memberIds, s.ID) {
+			continue
+		}
+		lastContact, err := time.Parse(time.RFC3339, s.LastContact)
+		seen := err == nil
+		if before != nil && seen && !lastContact.Before(*before) {
+			continue
+		}
+		if after != nil && (!seen || !lastContact.After(*after)) {
+			continue
+		}
+		state.Systems = append(state.Systems, jcSystemModel{
+			ID:           types.StringValue(s.ID),
+			DisplayName:  types.StringValue(s.DisplayName),
+			Hostname:     types.StringValue(s.Hostname),
+			Os:           types.StringValue(s.Os),
+			OsFamily:     types.StringValue(s.OsFamily),
+			OsVersion:    types.StringValue(s.Version),
+			AgentVersion: types.StringValue(s.AgentVersion),
+			SerialNumber: types.StringValue(s.SerialNumber),
+			LastContact:  types.StringValue(s.LastContact),
+			Active:       types.BoolValue(s.Active),
+		})
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *jcSystemsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of data source
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// parseTimeFilter parses an optional RFC3339 filter attribute, nil means the filter is not set.
+func parseTimeFilter(value types.String, attributePath path.Path, diags *diag.Diagnostics) *time.Time {
+	if value.IsNull() {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, value.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Time Filter",
+			"Expected an RFC3339 time, ex. 2024-01-02T15:04:05Z: "+err.Error(),
+		)
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,27 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceSystems_GroupMembers(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// An empty system group has no members
+				Config: providerConfig + `resource "jumpcloud_system_group" "empty" {
+											name = "systems_data_source_terraform_test"
+										}
+										data "jumpcloud_systems" "test" {
+											system_group_id = jumpcloud_system_group.empty.id
+										}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jumpcloud_systems.test", "systems.#", "0"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// systemTargetSchema returns the schema of the system_ids and system_group_ids attributes shared by
+// policies, policy groups and commands. An attribute left out of the configuration leaves those bindings
+// unmanaged, so they can be managed one at a time with associationResource instead.
+func systemTargetSchema(targetType, relation, associationResource string) schema.SetAttribute {
+	description := "IDs of the " + strings.ReplaceAll(targetType, "_", " ") + "s " + relation + ". " +
+		"Bindings not listed are removed, leave unset to manage bindings with " + associationResource
+	return schema.SetAttribute{
+		ElementType:         types.StringType,
+		Optional:            true,
+		Computed:            true,
+		Description:         description,
+		MarkdownDescription: strings.Replace(description, associationResource, "`"+associationResource+"`", 1),
+		PlanModifiers: []planmodifier.Set{
+			setplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// setSystemTargets binds a graph object to exactly the systems and system groups configured.
+// Attributes that are null in the configuration are not reconciled.
+func setSystemTargets(ctx context.Context, c *jumpcloud.Client, config tfsdk.Config, fromType, fromId string, systemIds, systemGroupIds types.Set) diag.Diagnostics {
+	var diags diag.Diagnostics
+	targets := []struct {
+		attribute  string
+		targetType string
+		ids        types.Set
+	}{
+		{"system_ids", "system", systemIds},
+		{"system_group_ids", "system_group", systemGroupIds},
+	}
+	for _, target := range targets {
+		var configured types.Set
+		diags.Append(config.GetAttribute(ctx, path.Root(target.attribute), &configured)...)
+		if diags.HasError() || configured.IsNull() {
+			continue
+		}
+		var ids []string
+		diags.Append(target.ids.ElementsAs(ctx, &ids, false)...)
+		if diags.HasError() {
+			return diags
+		}
+		tflog.Info(ctx, fmt.Sprintf("Binding %s %s to %d %ss", fromType, fromId, len(ids), target.targetType))
+		if err := setGraphAssociations(ctx, c, fromType, fromId, target.targetType, ids); err != nil {
+			diags.AddAttributeError(
+				path.Root(target.attribute),
+				"Error binding "+strings.ReplaceAll(fromType, "_", " "),
+				"Could not update the "+target.targetType+" bindings of "+fromId+": "+err.Error(),
+			)
+		}
+	}
+	return diags
+}
+
+// readSystemTargets returns the systems and system groups a graph object is bound to.
+func readSystemTargets(ctx context.Context, c *jumpcloud.Client, fromType, fromId string) (systemIds, systemGroupIds types.Set, diags diag.Diagnostics) {
+	for _, target := range []struct {
+		targetType string
+		ids        *types.Set
+	}{
+		{"system", &systemIds},
+		{"system_group", &systemGroupIds},
+	} {
+		ids, err := getGraphAssociationIDs(ctx, c, fromType, fromId, target.targetType)
+		if err != nil {
+			diags.AddError(
+				"Error Reading Bindings",
+				"Could not read the "+target.targetType+" bindings of "+fromId+": "+err.Error(),
+			)
+			return systemIds, systemGroupIds, diags
+		}
+		set, d := types.SetValueFrom(ctx, types.StringType, ids)
+		diags.Append(d...)
+		*target.ids = set
+	}
+	return systemIds, systemGroupIds, diags
+}
@@ -0,0 +1,186 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &jcUserDataSource{}
+	_ datasource.DataSourceWithConfigure        = &jcUserDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &jcUserDataSource{}
+)
+
+// jcUserDataSourceModel maps the data source schema data, a user and the organization it is read from.
+type jcUserDataSourceModel struct {
+	ID           types.String `tfsdk:"id"`
+	Username     types.String `tfsdk:"username"`
+	Email        types.String `tfsdk:"email"`
+	Firstname    types.String `tfsdk:"firstname"`
+	Lastname     types.String `tfsdk:"lastname"`
+	Department   types.String `tfsdk:"department"`
+	EmployeeType types.String `tfsdk:"employee_type"`
+	State        types.String `tfsdk:"state"`
+	MfaEnabled   types.Bool   `tfsdk:"mfa_enabled"`
+	Created      types.String `tfsdk:"created"`
+	Attributes   types.Map    `tfsdk:"attributes"`
+	OrgID        types.String `tfsdk:"org_id"`
+}
+
+// NewjcUserDataSource is a helper function to simplify the provider implementation.
+func NewjcUserDataSource() datasource.DataSource {
+	return &jcUserDataSource{}
+}
+
+// jcUserDataSource is the data source implementation.
+// It shares its model with the elements of jumpcloud_users.
+type jcUserDataSource struct {
+	client *jumpcloud.Client
+}
+
+// Metadata returns the data source type name.
+func (d *jcUserDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the schema for the data source.
+func (d *jcUserDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := jcUserAttributes()
+	attributes["id"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "The ID of the User. Conflicts with email and username",
+		MarkdownDescription: "The ID of the User. Conflicts with `email` and `username`",
+	}
+	attributes["email"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "The email address of the User. Conflicts with id and username",
+		MarkdownDescription: "The email address of the User. Conflicts with `id` and `username`",
+	}
+	attributes["username"] = schema.StringAttribute{
+		Optional:            true,
+		Computed:            true,
+		Description:         "The username of the User. Conflicts with id and email",
+		MarkdownDescription: "The username of the User. Conflicts with `id` and `email`",
+	}
+	attributes["org_id"] = orgIDDataSourceAttribute()
+	resp.Schema = schema.Schema{
+		Description:         "Looks up exactly one user by ID, email address or username",
+		MarkdownDescription: "Looks up exactly one user by ID, email address or username",
+		Attributes:          attributes,
+	}
+}
+
+// ConfigValidators requires exactly one way of selecting the user.
+func (d *jcUserDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("email"),
+			path.MatchRoot("username"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *jcUserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config jcUserDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = withOrg(d.client, config.OrgID)
+
+	// Look up the user by ID, or search for the one user with the email address or username
+	var user jumpcloud.SystemUser
+	if !config.ID.IsNull() {
+		var err error
+		user, err = getSystemUser(ctx, d.client, config.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Unable to Find Jumpcloud User",
+				"Could not read Jumpcloud User ID "+config.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	} else {
+		field, value := "email", config.Email.ValueString()
+		if !config.Username.IsNull() {
+			field, value = "username", config.Username.ValueString()
+		}
+		users, err := listSystemUsers(ctx, d.client, []string{field + ":$eq:" + value})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Jumpcloud Users",
+				err.Error(),
+			)
+			return
+		}
+		if len(users) != 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(field),
+				"Unable to Find Jumpcloud User",
+				fmt.Sprintf("Expected one user with %s %q, found %d.", field, value, len(users)),
+			)
+			return
+		}
+		user = users[0]
+	}
+	tflog.Info(ctx, fmt.Sprintf("Found Jumpcloud User: %s", user.ID))
+
+	// Map response to state
+	userState, diags := newjcUserModel(ctx, user)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state := jcUserDataSourceModel{
+		ID:           userState.ID,
+		Username:     userState.Username,
+		Email:        userState.Email,
+		Firstname:    userState.Firstname,
+		Lastname:     userState.Lastname,
+		Department:   userState.Department,
+		EmployeeType: userState.EmployeeType,
+		State:        userState.State,
+		MfaEnabled:   userState.MfaEnabled,
+		Created:      userState.Created,
+		Attributes:   userState.Attributes,
+		OrgID:        config.OrgID,
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *jcUserDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of data source
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceUser_LookupByEmail(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Look up a user by email address and by username
+				Config: providerConfig + `resource "jumpcloud_user" "user" {
+											username = "user_data_source_terraform_test"
+											email    = "user_data_source_terraform_test@example.com"
+										}
+										data "jumpcloud_user" "by_email" {
+											email = jumpcloud_user.user.email
+										}
+										data "jumpcloud_user" "by_username" {
+											username = jumpcloud_user.user.username
+										}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.jumpcloud_user.by_email", "id", "jumpcloud_user.user", "id"),
+					resource.TestCheckResourceAttrPair("data.jumpcloud_user.by_username", "email", "jumpcloud_user.user", "email"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceUser_NotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + `data "jumpcloud_user" "missing" { email = "missing_user_terraform_test@example.com" }`,
+				ExpectError: regexp.MustCompile(`found 0`),
+			},
+		},
+	})
+}
@@ -0,0 +1,407 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &jcUserResource{}
+	_ resource.ResourceWithConfigure   = &jcUserResource{}
+	_ resource.ResourceWithImportState = &jcUserResource{}
+)
+
+// NewUserResource is a helper function to simplify the provider implementation.
+func NewUserResource() resource.Resource {
+	return &jcUserResource{}
+}
+
+// jcUserResource is the resource implementation.
+type jcUserResource struct {
+	client *jumpcloud.Client
+}
+
+// UserResourceModel is the local model for this resource type.
+type UserResourceModel struct {
+	ID                          types.String `tfsdk:"id"`
+	Username                    types.String `tfsdk:"username"`
+	Email                       types.String `tfsdk:"email"`
+	FirstName                   types.String `tfsdk:"first_name"`
+	LastName                    types.String `tfsdk:"last_name"`
+	Department                  types.String `tfsdk:"department"`
+	CostCenter                  types.String `tfsdk:"cost_center"`
+	EmployeeType                types.String `tfsdk:"employee_type"`
+	Manager                     types.String `tfsdk:"manager"`
+	AccountLocked               types.Bool   `tfsdk:"account_locked"`
+	Suspended                   types.Bool   `tfsdk:"suspended"`
+	EnableUserPortalMultifactor types.Bool   `tfsdk:"enable_user_portal_multifactor"`
+	Attributes                  types.Map    `tfsdk:"attributes"`
+	State                       types.String `tfsdk:"state"`
+	OrgID                       types.String `tfsdk:"org_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *jcUserResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+// Schema defines the schema for the resource.
+func (r *jcUserResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "User ID",
+				MarkdownDescription: "User ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				Description:         "The login name of the user",
+				MarkdownDescription: "The login name of the user",
+			},
+			"email": schema.StringAttribute{
+				Required:            true,
+				Description:         "The email address of the user",
+				MarkdownDescription: "The email address of the user",
+			},
+			"first_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "The first name of the user",
+				MarkdownDescription: "The first name of the user",
+			},
+			"last_name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "The last name of the user",
+				MarkdownDescription: "The last name of the user",
+			},
+			"department": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "The department of the user",
+				MarkdownDescription: "The department of the user",
+			},
+			"cost_center": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "The cost center of the user",
+				MarkdownDescription: "The cost center of the user",
+			},
+			"employee_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "The employee type of the user, ex. Full Time or Contractor",
+				MarkdownDescription: "The employee type of the user, ex. `Full Time` or `Contractor`",
+			},
+			"manager": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "The user ID of this user's manager",
+				MarkdownDescription: "The user ID of this user's manager",
+			},
+			"account_locked": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether the user account is locked",
+				MarkdownDescription: "Whether the user account is locked",
+			},
+			"suspended": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether the user is suspended",
+				MarkdownDescription: "Whether the user is suspended",
+			},
+			"enable_user_portal_multifactor": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Require MFA when the user logs in to the user portal",
+				MarkdownDescription: "Require MFA when the user logs in to the user portal",
+			},
+			"attributes": schema.MapAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
+				ElementType:         types.StringType,
+				Description:         "Custom attributes of the user as name/value pairs",
+				MarkdownDescription: "Custom attributes of the user as name/value pairs",
+			},
+			"state": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Can be STAGED, ACTIVATED or SUSPENDED",
+				MarkdownDescription: "Can be `STAGED`, `ACTIVATED` or `SUSPENDED`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": orgIDResourceAttribute(),
+		},
+	}
+}
+
+// Create creates the resource and sets the initial Terraform state.
+func (r *jcUserResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan UserResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	// Cast local model to client model
+	payload, diags := plan.payload(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Create new user, check for errors
+	user, err := createSystemUser(ctx, r.client, payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating user",
+			"Could not create user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Created Jumpcloud User: %s", user.Username))
+
+	// Map response body to schema and populate Computed attribute values
+	plan, diags = newUserResourceModel(user, plan.OrgID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jcUserResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state UserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Get refreshed user value from JumpCloud
+	tflog.Info(ctx, fmt.Sprintf("Looking Up User ID: %s", state.ID.ValueString()))
+	user, err := getSystemUser(ctx, r.client, state.ID.ValueString())
+	if isNotFound(err) {
+		// The user was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Jumpcloud User",
+			"Could not read Jumpcloud User ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Overwrite items with refreshed state
+	state, diags = newUserResourceModel(user, state.OrgID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *jcUserResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state UserResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	// Cast local model to client model
+	payload, diags := plan.payload(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Update user, reference the state's user Id
+	user, err := updateSystemUser(ctx, r.client, state.ID.ValueString(), payload)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Modifying User",
+			"Could not modify User ID "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Map response body to schema and populate Computed attribute values
+	plan, diags = newUserResourceModel(user, plan.OrgID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *jcUserResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state UserResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Delete existing user. This object will be purged from the state file so there is no need to return values
+	err := deleteSystemUser(ctx, r.client, state.ID.ValueString())
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Deleting User",
+			"Could not delete user, unexpected error: "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jcUserResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of resource
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource state from a user ID, username or email address, optionally prefixed with org_id:.
+func (r *jcUserResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	orgID, userId := importOrgID(ctx, req, resp)
+	r.client = withOrg(r.client, orgID)
+	if !isObjectID(userId) {
+		// Anything that is not an object ID is looked up as an email or username
+		field := "username"
+		if strings.Contains(userId, "@") {
+			field = "email"
+		}
+		var err error
+		userId, err = findSystemUserID(ctx, r.client, field, userId)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Importing User",
+				"Could not resolve "+req.ID+" to a user ID: "+err.Error(),
+			)
+			return
+		}
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), userId)...)
+}
+
+// payload converts the local model into the API request body.
+func (m UserResourceModel) payload(ctx context.Context) (systemUserPayload, diag.Diagnostics) {
+	var attributes map[string]string
+	diags := m.Attributes.ElementsAs(ctx, &attributes, false)
+
+	payload := systemUserPayload{
+		Username:                    m.Username.ValueString(),
+		Email:                       m.Email.ValueString(),
+		Firstname:                   m.FirstName.ValueString(),
+		Lastname:                    m.LastName.ValueString(),
+		Department:                  m.Department.ValueString(),
+		CostCenter:                  m.CostCenter.ValueString(),
+		EmployeeType:                m.EmployeeType.ValueString(),
+		AccountLocked:               m.AccountLocked.ValueBool(),
+		Suspended:                   m.Suspended.ValueBool(),
+		EnableUserPortalMultifactor: m.EnableUserPortalMultifactor.ValueBool(),
+		Attributes:                  []systemUserAttribute{},
+	}
+	// An empty manager is sent as null so the API clears the relationship
+	if manager := m.Manager.ValueString(); manager != "" {
+		payload.Manager = &manager
+	}
+	for name, value := range attributes {
+		payload.Attributes = append(payload.Attributes, systemUserAttribute{Name: name, Value: value})
+	}
+	return payload, diags
+}
+
+// newUserResourceModel maps an API user of the organization orgID to the local model.
+func newUserResourceModel(user jumpcloud.SystemUser, orgID types.String) (UserResourceModel, diag.Diagnostics) {
+	attributes := map[string]attr.Value{}
+	for _, a := range user.Attributes {
+		attributes[a.Name] = types.StringValue(a.Value)
+	}
+	attributeMap, diags := types.MapValue(types.StringType, attributes)
+
+	return UserResourceModel{
+		ID:                          types.StringValue(user.ID),
+		Username:                    types.StringValue(user.Username),
+		Email:                       types.StringValue(user.Email),
+		FirstName:                   types.StringValue(user.Firstname),
+		LastName:                    types.StringValue(user.Lastname),
+		Department:                  types.StringValue(user.Department),
+		CostCenter:                  types.StringValue(user.CostCenter),
+		EmployeeType:                types.StringValue(user.EmployeeType),
+		Manager:                     types.StringValue(user.Manager),
+		AccountLocked:               types.BoolValue(user.AccountLocked),
+		Suspended:                   types.BoolValue(user.Suspended),
+		EnableUserPortalMultifactor: types.BoolValue(user.EnableUserPortalMultifactor),
+		Attributes:                  attributeMap,
+		State:                       types.StringValue(user.State),
+		OrgID:                       orgID,
+	}, diags
+}
@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceUser_CreateUser(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create a new user and verify that aspects of it are correct
+				Config: providerConfig + `resource "jumpcloud_user" "new_user" {
+											username   = "terraform_test_user"
+											email      = "terraform_test_user@example.com"
+											first_name = "Terraform"
+											last_name  = "Test"
+											department = "Engineering"
+										}`,
+				// Compose multiple test checks to verify the resource
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpcloud_user.new_user",
+						"username",
+						"terraform_test_user"),
+					resource.TestCheckResourceAttr("jumpcloud_user.new_user",
+						"department",
+						"Engineering"),
+					resource.TestCheckResourceAttr("jumpcloud_user.new_user",
+						"suspended",
+						"false"),
+					resource.TestCheckResourceAttrSet("jumpcloud_user.new_user",
+						"id"),
+				),
+			},
+			{
+				// Import the user by email
+				ResourceName:      "jumpcloud_user.new_user",
+				ImportState:       true,
+				ImportStateId:     "terraform_test_user@example.com",
+				ImportStateVerify: true,
+			},
+			{
+				// Suspend the user and move them to another department
+				Config: providerConfig + `resource "jumpcloud_user" "new_user" {
+											username   = "terraform_test_user"
+											email      = "terraform_test_user@example.com"
+											first_name = "Terraform"
+											last_name  = "Test"
+											department = "Finance"
+											suspended  = true
+										}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpcloud_user.new_user",
+						"department",
+						"Finance"),
+					resource.TestCheckResourceAttr("jumpcloud_user.new_user",
+						"suspended",
+						"true"),
+				),
+			},
+		},
+	})
+}
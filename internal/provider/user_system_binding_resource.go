@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                     = &jcUserSystemBindingResource{}
+	_ resource.ResourceWithConfigure        = &jcUserSystemBindingResource{}
+	_ resource.ResourceWithImportState      = &jcUserSystemBindingResource{}
+	_ resource.ResourceWithConfigValidators = &jcUserSystemBindingResource{}
+)
+
+// NewUserSystemBindingResource is a helper function to simplify the provider implementation.
+func NewUserSystemBindingResource() resource.Resource {
+	return &jcUserSystemBindingResource{}
+}
+
+// jcUserSystemBindingResource is the resource implementation.
+// It manages a single user or user group to system or system group edge and the sudo settings stored on it.
+type jcUserSystemBindingResource struct {
+	client *jumpcloud.Client
+}
+
+// UserSystemBindingResourceModel is the local model for this resource type.
+type UserSystemBindingResourceModel struct {
+	ID                  types.String `tfsdk:"id"`
+	UserID              types.String `tfsdk:"user_id"`
+	UserGroupID         types.String `tfsdk:"user_group_id"`
+	SystemID            types.String `tfsdk:"system_id"`
+	SystemGroupID       types.String `tfsdk:"system_group_id"`
+	SudoEnabled         types.Bool   `tfsdk:"sudo_enabled"`
+	SudoWithoutPassword types.Bool   `tfsdk:"sudo_without_password"`
+	OrgID               types.String `tfsdk:"org_id"`
+}
+
+// source returns the graph object type and ID of the bound user or user group.
+func (m UserSystemBindingResourceModel) source() (string, string) {
+	if !m.UserGroupID.IsNull() {
+		return "user_group", m.UserGroupID.ValueString()
+	}
+	return "user", m.UserID.ValueString()
+}
+
+// target returns the graph object type and ID of the system or system group.
+func (m UserSystemBindingResourceModel) target() (string, string) {
+	if !m.SystemGroupID.IsNull() {
+		return "system_group", m.SystemGroupID.ValueString()
+	}
+	return "system", m.SystemID.ValueString()
+}
+
+// bindingID returns the source_type/source_id/target_type/target_id ID of the binding.
+func (m UserSystemBindingResourceModel) bindingID() string {
+	sourceType, sourceId := m.source()
+	targetType, targetId := m.target()
+	return sourceType + "/" + sourceId + "/" + targetType + "/" + targetId
+}
+
+// Metadata returns the resource type name.
+func (r *jcUserSystemBindingResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_system_binding"
+}
+
+// Schema defines the schema for the resource.
+func (r *jcUserSystemBindingResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The binding ID in the form source_type/source_id/target_type/target_id, ex. user/<user id>/system/<system id>",
+				MarkdownDescription: "The binding ID in the form `source_type/source_id/target_type/target_id`, ex. `user/<user id>/system/<system id>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "User ID. Conflicts with user_group_id",
+				MarkdownDescription: "User ID. Conflicts with `user_group_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_group_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "User Group ID. Conflicts with user_id",
+				MarkdownDescription: "User Group ID. Conflicts with `user_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"system_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "System ID. Conflicts with system_group_id",
+				MarkdownDescription: "System ID. Conflicts with `system_group_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"system_group_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "System Group ID. Conflicts with system_id",
+				MarkdownDescription: "System Group ID. Conflicts with `system_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sudo_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Give the users administrator rights on the systems",
+				MarkdownDescription: "Give the users administrator rights on the systems",
+			},
+			"sudo_without_password": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Let the users run sudo without entering a password",
+				MarkdownDescription: "Let the users run sudo without entering a password",
+			},
+			"org_id": orgIDResourceAttribute(),
+		},
+	}
+}
+
+// ConfigValidators requires exactly one source and exactly one target.
+func (r *jcUserSystemBindingResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("user_id"),
+			path.MatchRoot("user_group_id"),
+		),
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("system_id"),
+			path.MatchRoot("system_group_id"),
+		),
+	}
+}
+
+// Create binds the user to the system and sets the initial Terraform state.
+func (r *jcUserSystemBindingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan UserSystemBindingResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	// Bind the user to the system with the planned sudo settings
+	sourceType, sourceId := plan.source()
+	targetType, targetId := plan.target()
+	tflog.Info(ctx, fmt.Sprintf("BINDING %s %s TO %s %s", sourceType, sourceId, targetType, targetId))
+	err := modifyGraphConnection(ctx, r.client, graphAssociationsPath(sourceType, sourceId), "add", targetType, targetId,
+		sudoAttributes(plan.SudoEnabled.ValueBool(), plan.SudoWithoutPassword.ValueBool()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Binding User to System",
+			"Could not bind "+sourceType+" "+sourceId+" to "+targetType+" "+targetId+": "+err.Error(),
+		)
+		return
+	}
+	plan.ID = types.StringValue(plan.bindingID())
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jcUserSystemBindingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state UserSystemBindingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Check that the binding still exists
+	sourceType, sourceId := state.source()
+	targetType, targetId := state.target()
+	association, err := getGraphAssociation(ctx, r.client, sourceType, sourceId, targetType, targetId)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Reading System Bindings",
+			"Could not read the bindings of "+sourceType+" "+sourceId+": "+err.Error(),
+		)
+		return
+	}
+	if association == nil {
+		// The binding, the user or the system was removed outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Pick up sudo settings changed in the console
+	enabled, withoutPassword := parseSudoAttributes(association.Attributes)
+	state.SudoEnabled = types.BoolValue(enabled)
+	state.SudoWithoutPassword = types.BoolValue(withoutPassword)
+	state.ID = types.StringValue(state.bindingID())
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update changes the sudo settings of the binding and sets the updated Terraform state on success.
+func (r *jcUserSystemBindingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Retrieve values from plan and state
+	var plan, state UserSystemBindingResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	// Only the sudo settings can change in place, the edge is updated when they differ from the state
+	if !plan.SudoEnabled.Equal(state.SudoEnabled) || !plan.SudoWithoutPassword.Equal(state.SudoWithoutPassword) {
+		sourceType, sourceId := plan.source()
+		targetType, targetId := plan.target()
+		tflog.Info(ctx, fmt.Sprintf("UPDATING SUDO OF %s %s ON %s %s", sourceType, sourceId, targetType, targetId))
+		err := modifyGraphConnection(ctx, r.client, graphAssociationsPath(sourceType, sourceId), "update", targetType, targetId,
+			sudoAttributes(plan.SudoEnabled.ValueBool(), plan.SudoWithoutPassword.ValueBool()))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Modifying System Binding",
+				"Could not update the sudo settings of "+sourceType+" "+sourceId+" on "+targetType+" "+targetId+": "+err.Error(),
+			)
+			return
+		}
+	}
+	plan.ID = types.StringValue(plan.bindingID())
+
+	// Set state to fully populated data
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the binding and removes the Terraform state on success.
+func (r *jcUserSystemBindingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state UserSystemBindingResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Remove only this binding
+	sourceType, sourceId := state.source()
+	targetType, targetId := state.target()
+	tflog.Info(ctx, fmt.Sprintf("UNBINDING %s %s FROM %s %s", sourceType, sourceId, targetType, targetId))
+	err := modifyGraphConnection(ctx, r.client, graphAssociationsPath(sourceType, sourceId), "remove", targetType, targetId, nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Removing System Binding",
+			"Could not unbind "+sourceType+" "+sourceId+" from "+targetType+" "+targetId+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jcUserSystemBindingResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of resource
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource state from a source_type/source_id/target_type/target_id composite ID, optionally prefixed with org_id:.
+func (r *jcUserSystemBindingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	_, id := importOrgID(ctx, req, resp)
+	parts := strings.Split(id, "/")
+	if len(parts) != 4 || parts[1] == "" || parts[3] == "" ||
+		(parts[0] != "user" && parts[0] != "user_group") || (parts[2] != "system" && parts[2] != "system_group") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: [org_id:]source_type/source_id/target_type/target_id where source_type is user or user_group and target_type is system or system_group. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(parts[0]+"_id"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(parts[2]+"_id"), parts[3])...)
+}
@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceUserSystemBinding_Sudo(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Bind a user group to a system group with sudo
+				Config: providerConfig + `resource "jumpcloud_usergroup" "admins" {
+											name        = "user_system_binding_terraform_test"
+											description = "This group made via terraform test"
+										}
+										resource "jumpcloud_system_group" "servers" {
+											name = "user_system_binding_terraform_test"
+										}
+										resource "jumpcloud_user_system_binding" "binding" {
+											user_group_id   = jumpcloud_usergroup.admins.id
+											system_group_id = jumpcloud_system_group.servers.id
+											sudo_enabled    = true
+										}`,
+				// Compose multiple test checks to verify the resource
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpcloud_user_system_binding.binding", "sudo_enabled", "true"),
+					resource.TestCheckResourceAttr("jumpcloud_user_system_binding.binding", "sudo_without_password", "false"),
+				),
+			},
+			{
+				// Allow sudo without a password in place
+				Config: providerConfig + `resource "jumpcloud_usergroup" "admins" {
+											name        = "user_system_binding_terraform_test"
+											description = "This group made via terraform test"
+										}
+										resource "jumpcloud_system_group" "servers" {
+											name = "user_system_binding_terraform_test"
+										}
+										resource "jumpcloud_user_system_binding" "binding" {
+											user_group_id         = jumpcloud_usergroup.admins.id
+											system_group_id       = jumpcloud_system_group.servers.id
+											sudo_enabled          = true
+											sudo_without_password = true
+										}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpcloud_user_system_binding.binding", "sudo_without_password", "true"),
+				),
+			},
+			{
+				ResourceName:      "jumpcloud_user_system_binding.binding",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
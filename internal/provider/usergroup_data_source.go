@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource                     = &jcUserGroupDetailsDataSource{}
+	_ datasource.DataSourceWithConfigure        = &jcUserGroupDetailsDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &jcUserGroupDetailsDataSource{}
+)
+
+// NewjcUserGroupDetailsDataSource is a helper function to simplify the provider implementation.
+func NewjcUserGroupDetailsDataSource() datasource.DataSource {
+	return &jcUserGroupDetailsDataSource{}
+}
+
+// jcUserGroupDetailsDataSource is the data source implementation.
+// It uses the model of the jumpcloud_usergroup resource so both expose the same attributes.
+type jcUserGroupDetailsDataSource struct {
+	client *jumpcloud.Client
+}
+
+// Metadata returns the data source type name.
+func (d *jcUserGroupDetailsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usergroup"
+}
+
+// Schema defines the schema for the data source.
+func (d *jcUserGroupDetailsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Looks up exactly one user group by exact name or ID",
+		MarkdownDescription: "Looks up exactly one user group by exact name or ID",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "User Group ID. Conflicts with name",
+				MarkdownDescription: "User Group ID. Conflicts with `name`",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "User Group Name, matched exactly. Conflicts with id",
+				MarkdownDescription: "User Group Name, matched exactly. Conflicts with `id`",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				Description:         "User Group Description",
+				MarkdownDescription: "User Group Description",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				Description:         "ex. user_group or device_group type",
+				MarkdownDescription: "ex. user_group or device_group type",
+			},
+			"email": schema.StringAttribute{
+				Computed:            true,
+				Description:         "User group email address",
+				MarkdownDescription: "User group email address",
+			},
+			"membership_method": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Can be STATIC or DYNAMIC_AUTOMATED or DYNAMIC_REVIEW_REQUIRED",
+				MarkdownDescription: "Can be `STATIC` or `DYNAMIC_AUTOMATED` or `DYNAMIC_REVIEW_REQUIRED`",
+			},
+			"member_query": schema.SingleNestedAttribute{
+				Computed:            true,
+				Description:         "Rules that select the members of a dynamic group, null for static groups",
+				MarkdownDescription: "Rules that select the members of a dynamic group, null for static groups",
+				Attributes: map[string]schema.Attribute{
+					"match": schema.StringAttribute{
+						Computed:            true,
+						Description:         "all (AND) or any (OR) of the filters must match a user",
+						MarkdownDescription: "`all` (AND) or `any` (OR) of the filters must match a user",
+					},
+					"filter": schema.ListNestedAttribute{
+						Computed:            true,
+						Description:         "User attribute filters, ex. department eq Engineering",
+						MarkdownDescription: "User attribute filters, ex. `department` `eq` `Engineering`",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: map[string]schema.Attribute{
+								"field": schema.StringAttribute{
+									Computed: true,
+								},
+								"operator": schema.StringAttribute{
+									Computed: true,
+								},
+								"value": schema.StringAttribute{
+									Computed: true,
+								},
+							},
+						},
+					},
+				},
+			},
+			"members": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "User emails associated with this group",
+				MarkdownDescription: "User emails associated with this group",
+			},
+			"org_id": orgIDDataSourceAttribute(),
+		},
+	}
+}
+
+// ConfigValidators requires exactly one way of selecting the group.
+func (d *jcUserGroupDetailsDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(
+			path.MatchRoot("id"),
+			path.MatchRoot("name"),
+		),
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *jcUserGroupDetailsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config UserGroupResourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = withOrg(d.client, config.OrgID)
+
+	// Look up the group by ID, or search for the one group with exactly this name
+	var group userGroupDetails
+	if !config.ID.IsNull() {
+		var err error
+		group, err = getUserGroupDetails(ctx, d.client, config.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Unable to Find Jumpcloud User Group",
+				"Could not read Jumpcloud Group ID "+config.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	} else {
+		name := config.Name.ValueString()
+		groups, err := listUserGroupDetails(ctx, d.client, []string{"name:$eq:" + name})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Jumpcloud User Groups",
+				err.Error(),
+			)
+			return
+		}
+		var matches []userGroupDetails
+		for _, g := range groups {
+			if g.Name == name {
+				matches = append(matches, g)
+			}
+		}
+		if len(matches) != 1 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name"),
+				"Unable to Find Jumpcloud User Group",
+				fmt.Sprintf("Expected one user group named %q, found %d.", name, len(matches)),
+			)
+			return
+		}
+		group = matches[0]
+	}
+	tflog.Info(ctx, fmt.Sprintf("Found Jumpcloud User Group: %s", group.ID))
+
+	// Get the members
+	members, err := listGraphConnections(ctx, d.client, "/api/v2/usergroups/"+group.ID+"/members", nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Jumpcloud User Group Members",
+			"Could not read members of Jumpcloud Group ID "+group.ID+": "+err.Error(),
+		)
+		return
+	}
+	memberEmails := []string{}
+	for _, member := range members {
+		user, err := getSystemUser(ctx, d.client, member.To.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to Read Jumpcloud User",
+				"Could not read member "+member.To.ID+" of Jumpcloud Group ID "+group.ID+": "+err.Error(),
+			)
+			return
+		}
+		memberEmails = append(memberEmails, user.Email)
+	}
+	returnedMembers, diags := types.SetValueFrom(ctx, types.StringType, memberEmails)
+	resp.Diagnostics.Append(diags...)
+
+	// Map response to state
+	state := UserGroupResourceModel{
+		ID:               types.StringValue(group.ID),
+		Name:             types.StringValue(group.Name),
+		Description:      types.StringValue(group.Description),
+		Type:             types.StringValue(group.Type),
+		Email:            types.StringValue(group.Email),
+		MembershipMethod: types.StringValue(group.MembershipMethod),
+		MemberQuery:      newMemberQueryModel(group),
+		Members:          returnedMembers,
+		OrgID:            config.OrgID,
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *jcUserGroupDetailsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of data source
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
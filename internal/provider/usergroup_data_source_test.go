@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceUserGroup_LookupByName(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Look up a group by its exact name
+				Config: providerConfig + `resource "jumpcloud_usergroup" "group" {
+											name        = "usergroup_data_source_terraform_test"
+											description = "This group made via terraform test"
+										}
+										data "jumpcloud_usergroup" "test" {
+											name = jumpcloud_usergroup.group.name
+										}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.jumpcloud_usergroup.test", "id", "jumpcloud_usergroup.group", "id"),
+					resource.TestCheckResourceAttrPair("data.jumpcloud_usergroup.test", "description", "jumpcloud_usergroup.group", "description"),
+					resource.TestCheckResourceAttrPair("data.jumpcloud_usergroup.test", "membership_method", "jumpcloud_usergroup.group", "membership_method"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceUserGroup_NotFound(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      providerConfig + `data "jumpcloud_usergroup" "missing" { name = "missing_usergroup_terraform_test" }`,
+				ExpectError: regexp.MustCompile(`found 0`),
+			},
+		},
+	})
+}
@@ -0,0 +1,246 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ resource.Resource                = &jcUserGroupMembershipResource{}
+	_ resource.ResourceWithConfigure   = &jcUserGroupMembershipResource{}
+	_ resource.ResourceWithImportState = &jcUserGroupMembershipResource{}
+)
+
+// NewUserGroupMembershipResource is a helper function to simplify the provider implementation.
+func NewUserGroupMembershipResource() resource.Resource {
+	return &jcUserGroupMembershipResource{}
+}
+
+// jcUserGroupMembershipResource is the resource implementation.
+// Unlike the members attribute of jcUserGroupsResource it manages a single group/user edge
+// and never touches the other members of the group.
+type jcUserGroupMembershipResource struct {
+	client *jumpcloud.Client
+}
+
+// UserGroupMembershipResourceModel is the local model for this resource type.
+type UserGroupMembershipResourceModel struct {
+	ID      types.String `tfsdk:"id"`
+	GroupID types.String `tfsdk:"group_id"`
+	User    types.String `tfsdk:"user"`
+	UserID  types.String `tfsdk:"user_id"`
+	OrgID   types.String `tfsdk:"org_id"`
+}
+
+// Metadata returns the resource type name.
+func (r *jcUserGroupMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usergroup_membership"
+}
+
+// Schema defines the schema for the resource.
+func (r *jcUserGroupMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The membership ID in the form group_id/user",
+				MarkdownDescription: "The membership ID in the form `group_id/user`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "User Group ID",
+				MarkdownDescription: "User Group ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user": schema.StringAttribute{
+				Required:            true,
+				Description:         "The email address or ID of the user to add to the group",
+				MarkdownDescription: "The email address or ID of the user to add to the group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The resolved ID of the user",
+				MarkdownDescription: "The resolved ID of the user",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"org_id": orgIDResourceAttribute(),
+		},
+	}
+}
+
+// Create adds the user to the group and sets the initial Terraform state.
+func (r *jcUserGroupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var plan UserGroupMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, plan.OrgID)
+
+	// Get the user id from the email
+	userId, err := resolveUserID(ctx, r.client, plan.User.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user"),
+			"Error resolving user",
+			"Could not resolve user "+plan.User.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+
+	// Add the member
+	groupId := plan.GroupID.ValueString()
+	err = modifyGraphConnection(ctx, r.client, "/api/v2/usergroups/"+groupId+"/members", "add", "user", userId, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error adding user to group",
+			"Could not add user "+plan.User.ValueString()+" to group "+groupId+": "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Added user %s to group %s", userId, groupId))
+
+	plan.ID = types.StringValue(groupId + "/" + plan.User.ValueString())
+	plan.UserID = types.StringValue(userId)
+
+	// Set state to fully populated data
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *jcUserGroupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Retrieve values from state
+	var state UserGroupMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Imported memberships do not know the user ID yet
+	userId := state.UserID.ValueString()
+	if userId == "" {
+		var err error
+		userId, err = resolveUserID(ctx, r.client, state.User.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error resolving user",
+				"Could not resolve user "+state.User.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+	}
+
+	// Check that the edge still exists
+	member, err := isUserGroupMember(ctx, r.client, state.GroupID.ValueString(), userId)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error Reading Jumpcloud Group Members",
+			"Could not read members of Jumpcloud Group ID "+state.GroupID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if !member {
+		// The user or the group was removed outside of Terraform
+		tflog.Info(ctx, fmt.Sprintf("User %s is no longer a member of group %s", userId, state.GroupID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(state.GroupID.ValueString() + "/" + state.User.ValueString())
+	state.UserID = types.StringValue(userId)
+
+	// Set refreshed state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called because every attribute requires replacement.
+func (r *jcUserGroupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan UserGroupMembershipResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+// Delete removes the user from the group and removes the Terraform state on success.
+func (r *jcUserGroupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Retrieve values from state
+	var state UserGroupMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	r.client = withOrg(r.client, state.OrgID)
+
+	// Remove only this member from the group, a member already gone is fine
+	groupId := state.GroupID.ValueString()
+	err := modifyGraphConnection(ctx, r.client, "/api/v2/usergroups/"+groupId+"/members", "remove", "user", state.UserID.ValueString(), nil)
+	if err != nil && !isNotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error removing user from group",
+			"Could not remove user "+state.User.ValueString()+" from group "+groupId+": "+err.Error(),
+		)
+		return
+	}
+}
+
+// Configure adds the provider configured client to the resource.
+func (r *jcUserGroupMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of resource
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+// ImportState imports the resource state from a group_id/user composite ID, optionally prefixed with org_id:.
+func (r *jcUserGroupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	_, id := importOrgID(ctx, req, resp)
+	groupId, user, found := strings.Cut(id, "/")
+	if !found || groupId == "" || user == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: [org_id:]group_id/user. Got: %q", req.ID),
+		)
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user"), user)...)
+}
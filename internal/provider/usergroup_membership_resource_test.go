@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccResourceUserGroupMembership_AddMember(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Add a user to a group that does not manage its own members
+				Config: providerConfig + `resource "jumpcloud_usergroup" "membership_group" {
+											name        = "membership_group_terraform_test"
+											description = "This group made via terraform test"
+										}
+										resource "jumpcloud_user" "membership_user" {
+											username = "membership_user_terraform_test"
+											email    = "membership_user_terraform_test@example.com"
+										}
+										resource "jumpcloud_usergroup_membership" "membership" {
+											group_id = jumpcloud_usergroup.membership_group.id
+											user     = jumpcloud_user.membership_user.email
+										}`,
+				// Compose multiple test checks to verify the resource
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("jumpcloud_usergroup_membership.membership",
+						"user_id",
+						"jumpcloud_user.membership_user",
+						"id"),
+				),
+			},
+			{
+				// Import the membership by group_id/user
+				ResourceName:      "jumpcloud_usergroup_membership.membership",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
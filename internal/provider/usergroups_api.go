@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+)
+
+// Member query types accepted by the v2 user group API.
+const (
+	memberQueryTypeFilter = "FilterQuery"
+	memberQueryTypeSearch = "SearchQuery"
+)
+
+// userGroupMemberQuery is the rule set of a dynamic user group.
+// A FilterQuery matches users that satisfy all Filters, a SearchQuery matches users that satisfy any Filter.Or entry.
+type userGroupMemberQuery struct {
+	QueryType string              `json:"queryType"`
+	Filters   []jumpcloud.Filters `json:"filters,omitempty"`
+	Filter    *searchQueryFilter  `json:"filter,omitempty"`
+}
+
+// searchQueryFilter is the filter of a SearchQuery, ex. {"or": [{"department": {"$eq": "Sales"}}]}.
+type searchQueryFilter struct {
+	Or []map[string]map[string]string `json:"or"`
+}
+
+// userGroupDetails is a user group including its dynamic membership settings.
+// The client model cannot represent SearchQuery member queries, so these calls are made directly.
+type userGroupDetails struct {
+	ID               string                `json:"id,omitempty"`
+	Name             string                `json:"name"`
+	Description      string                `json:"description"`
+	Type             string                `json:"type,omitempty"`
+	Email            string                `json:"email,omitempty"`
+	MembershipMethod string                `json:"membershipMethod,omitempty"`
+	MemberQuery      *userGroupMemberQuery `json:"memberQuery,omitempty"`
+}
+
+// getUserGroupDetails returns a user group by ID.
+func getUserGroupDetails(ctx context.Context, c *jumpcloud.Client, groupId string) (group userGroupDetails, err error) {
+	_, err = jcRequest(ctx, c, http.MethodGet, "/api/v2/usergroups/"+groupId, nil, nil, &group)
+	return group, err
+}
+
+// createUserGroupDetails creates a new user group.
+func createUserGroupDetails(ctx context.Context, c *jumpcloud.Client, newGroup userGroupDetails) (group userGroupDetails, err error) {
+	_, err = jcRequest(ctx, c, http.MethodPost, "/api/v2/usergroups", nil, newGroup, &group)
+	return group, err
+}
+
+// updateUserGroupDetails replaces the settings of a user group.
+func updateUserGroupDetails(ctx context.Context, c *jumpcloud.Client, groupId string, updatedGroup userGroupDetails) (group userGroupDetails, err error) {
+	_, err = jcRequest(ctx, c, http.MethodPut, "/api/v2/usergroups/"+groupId, nil, updatedGroup, &group)
+	return group, err
+}
+
+// listUserGroupDetails returns every user group matching the filters, following pagination.
+// Filters use the v2 filter syntax, ex. name:$eq:Engineering.
+func listUserGroupDetails(ctx context.Context, c *jumpcloud.Client, filters []string) ([]userGroupDetails, error) {
+	var groups []userGroupDetails
+	params := url.Values{
+		"limit": {strconv.Itoa(graphPageSize)},
+	}
+	if len(filters) > 0 {
+		params["filter"] = filters
+	}
+	for skip := 0; ; skip += graphPageSize {
+		var page []userGroupDetails
+		params.Set("skip", strconv.Itoa(skip))
+		if _, err := jcRequest(ctx, c, http.MethodGet, "/api/v2/usergroups", params, nil, &page); err != nil {
+			return nil, err
+		}
+		groups = append(groups, page...)
+		if len(page) < graphPageSize {
+			return groups, nil
+		}
+	}
+}
+
+// isUserGroupMember reports whether a user is a direct member of a user group.
+func isUserGroupMember(ctx context.Context, c *jumpcloud.Client, groupId, userId string) (bool, error) {
+	members, err := listGraphConnections(ctx, c, "/api/v2/usergroups/"+groupId+"/members", nil)
+	if err != nil {
+		return false, err
+	}
+	for _, member := range members {
+		if member.To.ID == userId {
+			return true, nil
+		}
+	}
+	return false, nil
+}
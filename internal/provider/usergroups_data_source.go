@@ -8,6 +8,7 @@ import (
 	"github.com/Spotnana-Tech/sec-jumpcloud-client-go"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -29,6 +30,7 @@ type jcUserGroupsModel struct {
 // TODO: Update this struct value to types.ListType or types.SetType if the data source returns a list or set of items.
 type jcUserGroupsDataSourceModel struct {
 	UserGroups []jcUserGroupsModel `tfsdk:"usergroups"`
+	OrgID      types.String        `tfsdk:"org_id"`
 }
 
 // NewjcUserGroupDataSource is a helper function to simplify the provider implementation.
@@ -78,6 +80,7 @@ func (d *jcUserGroupDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 					},
 				},
 			},
+			"org_id": orgIDDataSourceAttribute(),
 		},
 	}
 }
@@ -86,6 +89,11 @@ func (d *jcUserGroupDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 func (d *jcUserGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get all user groups
 	var state jcUserGroupsDataSourceModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("org_id"), &state.OrgID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = withOrg(d.client, state.OrgID)
 	groups, err := d.client.GetAllUserGroups()
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -4,22 +4,34 @@ import (
 	"context"
 	"fmt"
 	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"slices"
+	"strings"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &jcUserGroupsResource{}
-	_ resource.ResourceWithConfigure   = &jcUserGroupsResource{}
-	_ resource.ResourceWithImportState = &jcUserGroupsResource{}
+	_ resource.Resource                   = &jcUserGroupsResource{}
+	_ resource.ResourceWithConfigure      = &jcUserGroupsResource{}
+	_ resource.ResourceWithImportState    = &jcUserGroupsResource{}
+	_ resource.ResourceWithValidateConfig = &jcUserGroupsResource{}
+)
+
+// Membership methods of a user group.
+const (
+	membershipMethodStatic                = "STATIC"
+	membershipMethodDynamicAutomated      = "DYNAMIC_AUTOMATED"
+	membershipMethodDynamicReviewRequired = "DYNAMIC_REVIEW_REQUIRED"
 )
 
 // NewUserGroupsResource is a helper function to simplify the provider implementation.
@@ -34,13 +46,28 @@ type jcUserGroupsResource struct {
 
 // UserGroupResourceModel is the local model for this resource type.
 type UserGroupResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	Description      types.String `tfsdk:"description"`
-	Type             types.String `tfsdk:"type"`
-	Email            types.String `tfsdk:"email"`
-	MembershipMethod types.String `tfsdk:"membership_method"`
-	Members          types.Set    `tfsdk:"members"`
+	ID               types.String               `tfsdk:"id"`
+	Name             types.String               `tfsdk:"name"`
+	Description      types.String               `tfsdk:"description"`
+	Type             types.String               `tfsdk:"type"`
+	Email            types.String               `tfsdk:"email"`
+	MembershipMethod types.String               `tfsdk:"membership_method"`
+	MemberQuery      *UserGroupMemberQueryModel `tfsdk:"member_query"`
+	Members          types.Set                  `tfsdk:"members"`
+	OrgID            types.String               `tfsdk:"org_id"`
+}
+
+// UserGroupMemberQueryModel is the member_query block of a dynamic user group.
+type UserGroupMemberQueryModel struct {
+	Match   types.String                 `tfsdk:"match"`
+	Filters []UserGroupMemberFilterModel `tfsdk:"filter"`
+}
+
+// UserGroupMemberFilterModel is a single user attribute filter of a member query.
+type UserGroupMemberFilterModel struct {
+	Field    types.String `tfsdk:"field"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
 }
 
 // Metadata returns the resource type name.
@@ -96,21 +123,109 @@ func (r *jcUserGroupsResource) Schema(_ context.Context, _ resource.SchemaReques
 				},
 			},
 			"membership_method": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				Description:         "Can be STATIC or DYNAMIC_AUTOMATED or DYNAMIC_REVIEW_REQUIRED",
-				MarkdownDescription: "Can be STATIC or DYNAMIC_AUTOMATED or DYNAMIC_REVIEW_REQUIRED",
+				Description:         "Can be STATIC or DYNAMIC_AUTOMATED or DYNAMIC_REVIEW_REQUIRED. Dynamic groups require a member_query",
+				MarkdownDescription: "Can be `STATIC` or `DYNAMIC_AUTOMATED` or `DYNAMIC_REVIEW_REQUIRED`. Dynamic groups require a `member_query` block.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(membershipMethodStatic, membershipMethodDynamicAutomated, membershipMethodDynamicReviewRequired),
+				},
 			},
 			"members": schema.SetAttribute{
 				Computed:            true,
 				Optional:            true,
-				Description:         "User emails associated with this group",
-				MarkdownDescription: "This is a set of user emails associated with this group.",
+				Description:         "User emails associated with this group. When set, these are the only members of the group. When omitted, membership is left unmanaged and read from JumpCloud, ex. for dynamic groups or members managed with jumpcloud_usergroup_membership. Do not set it together with jumpcloud_usergroup_membership resources for the same group.",
+				MarkdownDescription: "This is a set of user emails associated with this group. When set, these are the only members of the group and any other member is removed. When omitted, membership is left unmanaged and read from JumpCloud, ex. for dynamic groups or members managed with `jumpcloud_usergroup_membership`. Do not set it together with `jumpcloud_usergroup_membership` resources for the same group, as each would remove the members of the other.",
 				ElementType:         types.StringType,
 			},
+			"org_id": orgIDResourceAttribute(),
 		},
+		Blocks: map[string]schema.Block{
+			"member_query": schema.SingleNestedBlock{
+				Description:         "Rules that select the members of a dynamic group",
+				MarkdownDescription: "Rules that select the members of a dynamic group",
+				Attributes: map[string]schema.Attribute{
+					"match": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString("all"),
+						Description:         "all (AND) or any (OR) of the filters must match a user",
+						MarkdownDescription: "`all` (AND) or `any` (OR) of the filters must match a user",
+						Validators: []validator.String{
+							stringvalidator.OneOf("all", "any"),
+						},
+					},
+				},
+				Blocks: map[string]schema.Block{
+					"filter": schema.ListNestedBlock{
+						Description:         "A user attribute filter, ex. department eq Engineering",
+						MarkdownDescription: "A user attribute filter, ex. `department` `eq` `Engineering`",
+						NestedObject: schema.NestedBlockObject{
+							Attributes: map[string]schema.Attribute{
+								"field": schema.StringAttribute{
+									Required:            true,
+									Description:         "The user attribute to filter on, ex. department, employeeType or location",
+									MarkdownDescription: "The user attribute to filter on, ex. `department`, `employeeType` or `location`",
+								},
+								"operator": schema.StringAttribute{
+									Optional:            true,
+									Computed:            true,
+									Default:             stringdefault.StaticString("eq"),
+									Description:         "Can be eq, ne, gt, ge, lt or le",
+									MarkdownDescription: "Can be `eq`, `ne`, `gt`, `ge`, `lt` or `le`",
+									Validators: []validator.String{
+										stringvalidator.OneOf(memberQueryOperators...),
+									},
+								},
+								"value": schema.StringAttribute{
+									Required:            true,
+									Description:         "The value to compare the attribute with",
+									MarkdownDescription: "The value to compare the attribute with",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ValidateConfig checks that dynamic membership settings are consistent.
+func (r *jcUserGroupsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config UserGroupResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() || config.MembershipMethod.IsUnknown() {
+		return
+	}
+
+	if !isDynamicMembership(config.MembershipMethod.ValueString()) {
+		if config.MemberQuery != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("member_query"),
+				"Unexpected member_query",
+				"member_query can only be set when membership_method is DYNAMIC_AUTOMATED or DYNAMIC_REVIEW_REQUIRED.",
+			)
+		}
+		return
+	}
+
+	if config.MemberQuery == nil || len(config.MemberQuery.Filters) == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("member_query"),
+			"Missing member_query",
+			"A member_query with at least one filter is required when membership_method is "+config.MembershipMethod.ValueString()+".",
+		)
+	}
+	if !config.Members.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("members"),
+			"Members of a dynamic group cannot be set",
+			"members is computed from member_query when membership_method is "+config.MembershipMethod.ValueString()+", remove it from the configuration.",
+		)
 	}
 }
 
@@ -123,6 +238,7 @@ func (r *jcUserGroupsResource) Create(ctx context.Context, req resource.CreateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	r.client = withOrg(r.client, plan.OrgID)
 
 	// Get the members emails from the plan
 	var planMemberEmails []string
@@ -137,13 +253,15 @@ func (r *jcUserGroupsResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Cast local model to client model
-	group := jumpcloud.UserGroup{
-		Name:        plan.Name.ValueString(),
-		Description: plan.Description.ValueString(),
+	group := userGroupDetails{
+		Name:             plan.Name.ValueString(),
+		Description:      plan.Description.ValueString(),
+		MembershipMethod: plan.MembershipMethod.ValueString(),
+		MemberQuery:      plan.MemberQuery.apiMemberQuery(),
 	}
 
 	// Create new group, check for errors
-	g, err := r.client.CreateUserGroup(group)
+	g, err := createUserGroupDetails(ctx, r.client, group)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating group",
@@ -166,7 +284,7 @@ func (r *jcUserGroupsResource) Create(ctx context.Context, req resource.CreateRe
 	}
 
 	// Get the newly created group
-	newGroup, _ := r.client.GetUserGroup(g.ID)
+	newGroup, _ := getUserGroupDetails(ctx, r.client, g.ID)
 
 	// Get the members
 	var memberEmails []attr.Value // This is the terraform structure requirement
@@ -184,7 +302,9 @@ func (r *jcUserGroupsResource) Create(ctx context.Context, req resource.CreateRe
 		Email:            types.StringValue(newGroup.Email),
 		Type:             types.StringValue(newGroup.Type),
 		MembershipMethod: types.StringValue(newGroup.MembershipMethod),
+		MemberQuery:      plan.MemberQuery,
 		Members:          returnedMembers,
+		OrgID:            plan.OrgID,
 	}
 
 	// Set state to fully populated data
@@ -204,10 +324,16 @@ func (r *jcUserGroupsResource) Read(ctx context.Context, req resource.ReadReques
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	r.client = withOrg(r.client, state.OrgID)
 
 	// Get refreshed group value from the jumpcloud client
 	tflog.Info(ctx, fmt.Sprintf("Looking Up Group ID: %s", state.ID.ValueString()))
-	group, err := r.client.GetUserGroup(state.ID.ValueString())
+	group, err := getUserGroupDetails(ctx, r.client, state.ID.ValueString())
+	if isNotFound(err) {
+		// The group was deleted outside of Terraform
+		resp.State.RemoveResource(ctx)
+		return
+	}
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Jumpcloud Group",
@@ -232,7 +358,9 @@ func (r *jcUserGroupsResource) Read(ctx context.Context, req resource.ReadReques
 		Type:             types.StringValue(group.Type),
 		Email:            types.StringValue(group.Email),
 		MembershipMethod: types.StringValue(group.MembershipMethod),
+		MemberQuery:      newMemberQueryModel(group),
 		Members:          returnedMembers,
+		OrgID:            state.OrgID,
 	}
 
 	// Set refreshed state
@@ -253,6 +381,7 @@ func (r *jcUserGroupsResource) Update(ctx context.Context, req resource.UpdateRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	r.client = withOrg(r.client, plan.OrgID)
 
 	// Isolate the members from the plan and state
 	stateMembers, _ := state.Members.ToSetValue(ctx)
@@ -265,13 +394,15 @@ func (r *jcUserGroupsResource) Update(ctx context.Context, req resource.UpdateRe
 	stateMembers.ElementsAs(ctx, &oldMembers, false)
 
 	// Cast local model to client model
-	groupModification := jumpcloud.UserGroup{
-		Name:        plan.Name.ValueString(),
-		Description: plan.Description.ValueString(),
+	groupModification := userGroupDetails{
+		Name:             plan.Name.ValueString(),
+		Description:      plan.Description.ValueString(),
+		MembershipMethod: plan.MembershipMethod.ValueString(),
+		MemberQuery:      plan.MemberQuery.apiMemberQuery(),
 	}
 
 	// Update group, reference the state's group Id
-	_, err := r.client.UpdateUserGroup(state.ID.ValueString(), groupModification)
+	_, err := updateUserGroupDetails(ctx, r.client, state.ID.ValueString(), groupModification)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Modifying Group",
@@ -280,6 +411,15 @@ func (r *jcUserGroupsResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	// Members of dynamic groups are selected by the member query, and when members is omitted
+	// membership is left to jumpcloud_usergroup_membership resources, skip straight to the refresh
+	var configMembers types.Set
+	diags = req.Config.GetAttribute(ctx, path.Root("members"), &configMembers)
+	resp.Diagnostics.Append(diags...)
+	if isDynamicMembership(plan.MembershipMethod.ValueString()) || configMembers.IsNull() {
+		newMembers, oldMembers = nil, nil
+	}
+
 	// Get current group membership
 	var currentMemberEmails []string
 	currentMembers, _ := r.client.GetGroupMembers(state.ID.ValueString())
@@ -340,7 +480,9 @@ func (r *jcUserGroupsResource) Update(ctx context.Context, req resource.UpdateRe
 		Type:             types.StringValue(groupState.Type),
 		Email:            types.StringValue(groupState.Email),
 		MembershipMethod: types.StringValue(groupState.MembershipMethod),
+		MemberQuery:      plan.MemberQuery,
 		Members:          finalMembers,
+		OrgID:            plan.OrgID,
 	}
 
 	// Set state to fully populated data
@@ -360,6 +502,7 @@ func (r *jcUserGroupsResource) Delete(ctx context.Context, req resource.DeleteRe
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	r.client = withOrg(r.client, state.OrgID)
 
 	// Delete existing group. This object will be purged from the state file so there is no need to return values
 	err := r.client.DeleteUserGroup(state.ID.ValueString())
@@ -391,8 +534,111 @@ func (r *jcUserGroupsResource) Configure(_ context.Context, req resource.Configu
 	r.client = client
 }
 
-// ImportState imports the resource state from live resources via their ID attribute.
+// ImportState imports the resource state from live resources via their ID attribute, optionally prefixed with org_id:.
 func (r *jcUserGroupsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	_, id := importOrgID(ctx, req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// memberQueryOperators are the filter operators accepted in a member query.
+var memberQueryOperators = []string{"eq", "ne", "gt", "ge", "lt", "le"}
+
+// isDynamicMembership reports whether a membership method selects members with a member query.
+func isDynamicMembership(method string) bool {
+	return method == membershipMethodDynamicAutomated || method == membershipMethodDynamicReviewRequired
+}
+
+// apiMemberQuery converts the member_query block to the API representation.
+// Filters that must all match are sent as a FilterQuery, filters of which any may match as a SearchQuery.
+func (m *UserGroupMemberQueryModel) apiMemberQuery() *userGroupMemberQuery {
+	if m == nil {
+		return nil
+	}
+	if m.Match.ValueString() == "any" {
+		filter := &searchQueryFilter{}
+		for _, f := range m.Filters {
+			filter.Or = append(filter.Or, map[string]map[string]string{
+				f.Field.ValueString(): {searchQueryOperator(f.Operator.ValueString()): f.Value.ValueString()},
+			})
+		}
+		return &userGroupMemberQuery{QueryType: memberQueryTypeSearch, Filter: filter}
+	}
+	var filters []jumpcloud.Filters
+	for _, f := range m.Filters {
+		filters = append(filters, jumpcloud.Filters{
+			Field:    f.Field.ValueString(),
+			Operator: f.Operator.ValueString(),
+			Value:    f.Value.ValueString(),
+		})
+	}
+	return &userGroupMemberQuery{QueryType: memberQueryTypeFilter, Filters: filters}
+}
+
+// newMemberQueryModel maps the member query of a dynamic group to the member_query block.
+func newMemberQueryModel(group userGroupDetails) *UserGroupMemberQueryModel {
+	query := group.MemberQuery
+	if !isDynamicMembership(group.MembershipMethod) || query == nil {
+		return nil
+	}
+	if query.QueryType == memberQueryTypeSearch {
+		model := &UserGroupMemberQueryModel{Match: types.StringValue("any")}
+		if query.Filter != nil {
+			for _, entry := range query.Filter.Or {
+				for _, field := range sortedKeys(entry) {
+					for _, op := range sortedKeys(entry[field]) {
+						model.Filters = append(model.Filters, UserGroupMemberFilterModel{
+							Field:    types.StringValue(field),
+							Operator: types.StringValue(filterOperator(op)),
+							Value:    types.StringValue(entry[field][op]),
+						})
+					}
+				}
+			}
+		}
+		return model
+	}
+	model := &UserGroupMemberQueryModel{Match: types.StringValue("all")}
+	for _, f := range query.Filters {
+		model.Filters = append(model.Filters, UserGroupMemberFilterModel{
+			Field:    types.StringValue(f.Field),
+			Operator: types.StringValue(f.Operator),
+			Value:    types.StringValue(f.Value),
+		})
+	}
+	return model
+}
+
+// searchQueryOperator maps a filter operator (ex. ge) to its search query form (ex. $gte).
+func searchQueryOperator(op string) string {
+	switch op {
+	case "ge":
+		return "$gte"
+	case "le":
+		return "$lte"
+	default:
+		return "$" + op
+	}
+}
+
+// filterOperator maps a search query operator (ex. $gte) back to its filter form (ex. ge).
+func filterOperator(op string) string {
+	switch op = strings.TrimPrefix(op, "$"); op {
+	case "gte":
+		return "ge"
+	case "lte":
+		return "le"
+	default:
+		return op
+	}
+}
+
+// sortedKeys returns the keys of m in sorted order so state is deterministic.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
 }
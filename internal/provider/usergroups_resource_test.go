@@ -32,3 +32,42 @@ func TestAccDataSourceUserGroups_CreateGroup(t *testing.T) {
 		},
 	})
 }
+
+func TestAccResourceUserGroups_CreateDynamicGroup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Create a dynamic user group and verify that the member query is stored
+				Config: providerConfig + `resource "jumpcloud_usergroup" "dynamic_usergroup" {
+											name              = "dynamic_usergroup_terraform_test"
+											description       = "This group made via terraform test"
+											membership_method = "DYNAMIC_REVIEW_REQUIRED"
+											member_query {
+												match = "any"
+												filter {
+													field = "department"
+													value = "Engineering"
+												}
+												filter {
+													field = "employeeType"
+													value = "Contractor"
+												}
+											}
+										}`,
+				// Compose multiple test checks to verify the resource
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("jumpcloud_usergroup.dynamic_usergroup",
+						"membership_method",
+						"DYNAMIC_REVIEW_REQUIRED"),
+					resource.TestCheckResourceAttr("jumpcloud_usergroup.dynamic_usergroup",
+						"member_query.filter.#",
+						"2"),
+					resource.TestCheckResourceAttr("jumpcloud_usergroup.dynamic_usergroup",
+						"member_query.filter.0.operator",
+						"eq"),
+				),
+			},
+		},
+	})
+}
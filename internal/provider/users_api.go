@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+)
+
+// systemUserAttribute is a custom attribute stored on a system user.
+type systemUserAttribute struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// systemUserPayload is the body sent when creating or updating a system user.
+// Fields are not omitempty so that clearing a value in the configuration clears it in JumpCloud.
+type systemUserPayload struct {
+	Username                    string                `json:"username"`
+	Email                       string                `json:"email"`
+	Firstname                   string                `json:"firstname"`
+	Lastname                    string                `json:"lastname"`
+	Department                  string                `json:"department"`
+	CostCenter                  string                `json:"costCenter"`
+	EmployeeType                string                `json:"employeeType"`
+	Manager                     *string               `json:"manager"`
+	AccountLocked               bool                  `json:"account_locked"`
+	Suspended                   bool                  `json:"suspended"`
+	EnableUserPortalMultifactor bool                  `json:"enable_user_portal_multifactor"`
+	Attributes                  []systemUserAttribute `json:"attributes"`
+}
+
+// systemUserSearchResult is the v1 list response for system users.
+type systemUserSearchResult struct {
+	TotalCount int                    `json:"totalCount"`
+	Results    []jumpcloud.SystemUser `json:"results"`
+}
+
+// getSystemUser returns a system user by ID.
+func getSystemUser(ctx context.Context, c *jumpcloud.Client, userId string) (user jumpcloud.SystemUser, err error) {
+	_, err = jcRequest(ctx, c, http.MethodGet, "/api/systemusers/"+userId, nil, nil, &user)
+	return user, err
+}
+
+// createSystemUser creates a new system user.
+func createSystemUser(ctx context.Context, c *jumpcloud.Client, payload systemUserPayload) (user jumpcloud.SystemUser, err error) {
+	_, err = jcRequest(ctx, c, http.MethodPost, "/api/systemusers", nil, payload, &user)
+	return user, err
+}
+
+// updateSystemUser replaces the managed fields of a system user.
+func updateSystemUser(ctx context.Context, c *jumpcloud.Client, userId string, payload systemUserPayload) (user jumpcloud.SystemUser, err error) {
+	_, err = jcRequest(ctx, c, http.MethodPut, "/api/systemusers/"+userId, nil, payload, &user)
+	return user, err
+}
+
+// deleteSystemUser deletes a system user.
+func deleteSystemUser(ctx context.Context, c *jumpcloud.Client, userId string) error {
+	_, err := jcRequest(ctx, c, http.MethodDelete, "/api/systemusers/"+userId, nil, nil, nil)
+	return err
+}
+
+// listSystemUsers returns every user matching the filters, following pagination.
+// Filters use the v1 filter syntax, ex. department:$eq:Engineering.
+func listSystemUsers(ctx context.Context, c *jumpcloud.Client, filters []string) ([]jumpcloud.SystemUser, error) {
+	var users []jumpcloud.SystemUser
+	params := url.Values{
+		"limit": {strconv.Itoa(graphPageSize)},
+	}
+	if len(filters) > 0 {
+		params["filter"] = filters
+	}
+	for skip := 0; ; skip += graphPageSize {
+		var page systemUserSearchResult
+		params.Set("skip", strconv.Itoa(skip))
+		if _, err := jcRequest(ctx, c, http.MethodGet, "/api/systemusers", params, nil, &page); err != nil {
+			return nil, err
+		}
+		users = append(users, page.Results...)
+		if len(page.Results) < graphPageSize || len(users) >= page.TotalCount {
+			return users, nil
+		}
+	}
+}
+
+// findSystemUserID returns the ID of the single user whose field exactly equals value.
+// An error is returned if no user or more than one user matches.
+func findSystemUserID(ctx context.Context, c *jumpcloud.Client, field, value string) (string, error) {
+	var result systemUserSearchResult
+	params := url.Values{
+		"filter": {field + ":$eq:" + value},
+		"fields": {"_id " + field},
+		"limit":  {"2"},
+	}
+	_, err := jcRequest(ctx, c, http.MethodGet, "/api/systemusers", params, nil, &result)
+	if err != nil {
+		return "", err
+	}
+	switch len(result.Results) {
+	case 0:
+		return "", fmt.Errorf("no user found with %s %q", field, value)
+	case 1:
+		return result.Results[0].ID, nil
+	default:
+		return "", fmt.Errorf("%d users found with %s %q, expected exactly one", result.TotalCount, field, value)
+	}
+}
+
+// resolveUserID returns userRef unchanged if it is already an ID, otherwise looks it up by email address.
+func resolveUserID(ctx context.Context, c *jumpcloud.Client, userRef string) (string, error) {
+	if isObjectID(userRef) {
+		return userRef, nil
+	}
+	return findSystemUserID(ctx, c, "email", userRef)
+}
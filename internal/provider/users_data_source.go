@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	jumpcloud "github.com/Spotnana-Tech/sec-jumpcloud-client-go"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var (
+	_ datasource.DataSource              = &jcUsersDataSource{}
+	_ datasource.DataSourceWithConfigure = &jcUsersDataSource{}
+)
+
+// jcUserModel maps a user to a Go type.
+type jcUserModel struct {
+	ID           types.String `tfsdk:"id"`
+	Username     types.String `tfsdk:"username"`
+	Email        types.String `tfsdk:"email"`
+	Firstname    types.String `tfsdk:"firstname"`
+	Lastname     types.String `tfsdk:"lastname"`
+	Department   types.String `tfsdk:"department"`
+	EmployeeType types.String `tfsdk:"employee_type"`
+	State        types.String `tfsdk:"state"`
+	MfaEnabled   types.Bool   `tfsdk:"mfa_enabled"`
+	Created      types.String `tfsdk:"created"`
+	Attributes   types.Map    `tfsdk:"attributes"`
+}
+
+// jcUsersDataSourceModel maps the data source schema data.
+type jcUsersDataSourceModel struct {
+	Department   types.String  `tfsdk:"department"`
+	EmployeeType types.String  `tfsdk:"employee_type"`
+	State        types.String  `tfsdk:"state"`
+	EmailDomain  types.String  `tfsdk:"email_domain"`
+	Attributes   types.Map     `tfsdk:"attributes"`
+	CreatedAfter types.String  `tfsdk:"created_after"`
+	Users        []jcUserModel `tfsdk:"users"`
+	OrgID        types.String  `tfsdk:"org_id"`
+}
+
+// newjcUserModel maps a user returned by the API to the data source model.
+func newjcUserModel(ctx context.Context, user jumpcloud.SystemUser) (jcUserModel, diag.Diagnostics) {
+	attributes := map[string]string{}
+	for _, a := range user.Attributes {
+		attributes[a.Name] = a.Value
+	}
+	attributeValues, diags := types.MapValueFrom(ctx, types.StringType, attributes)
+	return jcUserModel{
+		ID:           types.StringValue(user.ID),
+		Username:     types.StringValue(user.Username),
+		Email:        types.StringValue(user.Email),
+		Firstname:    types.StringValue(user.Firstname),
+		Lastname:     types.StringValue(user.Lastname),
+		Department:   types.StringValue(user.Department),
+		EmployeeType: types.StringValue(user.EmployeeType),
+		State:        types.StringValue(user.State),
+		MfaEnabled:   types.BoolValue(user.Mfa.Configured || user.TotpEnabled),
+		Created:      types.StringValue(user.Created),
+		Attributes:   attributeValues,
+	}, diags
+}
+
+// jcUserAttributes returns the schema of the attributes of a user, shared by the user data sources.
+func jcUserAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Computed:            true,
+			Description:         "The ID of the User",
+			MarkdownDescription: "The ID of the User",
+		},
+		"username": schema.StringAttribute{
+			Computed: true,
+		},
+		"email": schema.StringAttribute{
+			Computed: true,
+		},
+		"firstname": schema.StringAttribute{
+			Computed: true,
+		},
+		"lastname": schema.StringAttribute{
+			Computed: true,
+		},
+		"department": schema.StringAttribute{
+			Computed: true,
+		},
+		"employee_type": schema.StringAttribute{
+			Computed: true,
+		},
+		"state": schema.StringAttribute{
+			Computed:            true,
+			Description:         "Can be ACTIVATED, STAGED or SUSPENDED",
+			MarkdownDescription: "Can be `ACTIVATED`, `STAGED` or `SUSPENDED`",
+		},
+		"mfa_enabled": schema.BoolAttribute{
+			Computed:            true,
+			Description:         "Whether the user has configured MFA",
+			MarkdownDescription: "Whether the user has configured MFA",
+		},
+		"created": schema.StringAttribute{
+			Computed:            true,
+			Description:         "When the user was created",
+			MarkdownDescription: "When the user was created",
+		},
+		"attributes": schema.MapAttribute{
+			Computed:            true,
+			ElementType:         types.StringType,
+			Description:         "Custom attributes of the user",
+			MarkdownDescription: "Custom attributes of the user",
+		},
+	}
+}
+
+// NewjcUsersDataSource is a helper function to simplify the provider implementation.
+func NewjcUsersDataSource() datasource.DataSource {
+	return &jcUsersDataSource{}
+}
+
+// jcUsersDataSource is the data source implementation.
+// This struct accepts a client pointer to the JumpCloud Go client so terraform can make its changes to the system.
+type jcUsersDataSource struct {
+	client *jumpcloud.Client
+}
+
+// Metadata returns the data source type name.
+func (d *jcUsersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+// Schema defines the schema for the data source.
+func (d *jcUsersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"department": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return users of this department",
+				MarkdownDescription: "Only return users of this department",
+			},
+			"employee_type": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return users of this employee type",
+				MarkdownDescription: "Only return users of this employee type",
+			},
+			"state": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return users in this state, can be ACTIVATED, STAGED or SUSPENDED",
+				MarkdownDescription: "Only return users in this state, can be `ACTIVATED`, `STAGED` or `SUSPENDED`",
+				Validators: []validator.String{
+					stringvalidator.OneOf("ACTIVATED", "STAGED", "SUSPENDED"),
+				},
+			},
+			"email_domain": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return users whose email address is in this domain, case insensitive",
+				MarkdownDescription: "Only return users whose email address is in this domain, case insensitive",
+			},
+			"attributes": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Only return users having all these custom attribute values",
+				MarkdownDescription: "Only return users having all these custom attribute values",
+			},
+			"created_after": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only return users created after this RFC3339 time",
+				MarkdownDescription: "Only return users created after this RFC3339 time",
+			},
+			"users": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "A list of Jumpcloud Users",
+				MarkdownDescription: "A list of Jumpcloud Users",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: jcUserAttributes(),
+				},
+			},
+			"org_id": orgIDDataSourceAttribute(),
+		},
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *jcUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state jcUsersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d.client = withOrg(d.client, state.OrgID)
+
+	createdAfter := parseTimeFilter(state.CreatedAfter, path.Root("created_after"), &resp.Diagnostics)
+	wantAttributes := map[string]string{}
+	if !state.Attributes.IsNull() {
+		resp.Diagnostics.Append(state.Attributes.ElementsAs(ctx, &wantAttributes, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Exact matches are filtered by JumpCloud
+	var filters []string
+	for field, value := range map[string]types.String{
+		"department":   state.Department,
+		"employeeType": state.EmployeeType,
+		"state":        state.State,
+	} {
+		if !value.IsNull() {
+			filters = append(filters, field+":$eq:"+value.ValueString())
+		}
+	}
+	slices.Sort(filters)
+	users, err := listSystemUsers(ctx, d.client, filters)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Jumpcloud Users",
+			err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Read Jumpcloud Users: %v", len(users)))
+
+	// Map response to state, the remaining filters are applied locally
+	domain := "@" + strings.ToLower(strings.TrimPrefix(state.EmailDomain.ValueString(), "@"))
+	state.Users = []jcUserModel{}
+	for _, user := range users {
+		if !state.EmailDomain.IsNull() && !strings.HasSuffix(strings.ToLower(user.Email), domain) {
+			continue
+		}
+		if createdAfter != nil {
+			created, err := time.Parse(time.RFC3339, user.Created)
+			if err != nil || !created.After(*createdAfter) {
+				continue
+			}
+		}
+		userState, diags := newjcUserModel(ctx, user)
+		resp.Diagnostics.Append(diags...)
+		if !hasAttributes(userState.Attributes, wantAttributes) {
+			continue
+		}
+		state.Users = append(state.Users, userState)
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *jcUsersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	// This is where we import our client for this type of data source
+	client, ok := req.ProviderData.(*jumpcloud.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *jumpcloud.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+// hasAttributes reports whether the custom attributes of a user include every wanted name and value.
+func hasAttributes(attributes types.Map, want map[string]string) bool {
+	have := attributes.Elements()
+	for name, value := range want {
+		if v, ok := have[name]; !ok || !v.Equal(types.StringValue(value)) {
+			return false
+		}
+	}
+	return true
+}
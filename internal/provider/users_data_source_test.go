@@ -0,0 +1,31 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceUsers_FilterByDepartment(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// Find a user by department and email domain
+				Config: providerConfig + `resource "jumpcloud_user" "user" {
+											username   = "users_data_source_terraform_test"
+											email      = "users_data_source_terraform_test@example.com"
+											department = "users_data_source_terraform_test"
+										}
+										data "jumpcloud_users" "test" {
+											department   = jumpcloud_user.user.department
+											email_domain = "EXAMPLE.com"
+										}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jumpcloud_users.test", "users.#", "1"),
+					resource.TestCheckResourceAttrPair("data.jumpcloud_users.test", "users.0.id", "jumpcloud_user.user", "id"),
+				),
+			},
+		},
+	})
+}